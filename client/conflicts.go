@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+
+	"hr-system/hrclient"
+	"hr-system/shared"
+)
+
+type ConflictAction int
+
+const (
+	ConflictCancel ConflictAction = iota
+	ConflictApply
+	ConflictRetry
+)
+
+// ResolveConflict muestra qué cambió en el servidor desde que se leyó el
+// empleado y pregunta cómo continuar. applyLabel describe la opción de
+// reenviar los cambios propios sobre la versión actual.
+func (c *Client) ResolveConflict(before *shared.EmpleadoDetailResponseDTO, conflict *hrclient.Error, applyLabel string) (*shared.EmpleadoDetailResponseDTO, ConflictAction) {
+	fmt.Printf("\n--- CONFLICTO DE EDICIÓN ---\n%s\n", conflict.Message)
+	latest, err := conflict.Current()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return nil, ConflictCancel
+	}
+	trimFechaNac(latest)
+	c.PrintEmpleadoChanges(before, latest)
+
+	fmt.Printf("\n1. %s\n", applyLabel)
+	fmt.Println("2. Reintentar: volver a editar partiendo de los datos actuales")
+	fmt.Println("3. Cancelar")
+	switch c.ReadInput("Seleccione una opción: ") {
+	case "1":
+		return latest, ConflictApply
+	case "2":
+		return latest, ConflictRetry
+	default:
+		return latest, ConflictCancel
+	}
+}
+
+func (c *Client) PrintEmpleadoChanges(before, after *shared.EmpleadoDetailResponseDTO) {
+	fmt.Println("Cambios realizados por otro usuario:")
+	changes := 0
+	printChange := func(campo, antes, ahora string) {
+		if antes != ahora {
+			fmt.Printf("  %s: %s -> %s\n", campo, antes, ahora)
+			changes++
+		}
+	}
+	printChange("Primer nombre", before.PrimerNombre, after.PrimerNombre)
+	printChange("Segundo nombre", optionalText(before.SegundoNombre, "(vacío)"), optionalText(after.SegundoNombre, "(vacío)"))
+	printChange("Email", before.Email, after.Email)
+	printChange("Fecha nacimiento", before.FechaNac, after.FechaNac)
+	printChange("Cargo", before.CargoNombre, after.CargoNombre)
+	printChange("Departamento", before.DepartamentoNombre, after.DepartamentoNombre)
+	printChange("Gerente", optionalText(before.GerenteNombre, "(sin gerente)"), optionalText(after.GerenteNombre, "(sin gerente)"))
+	printChange("Sueldo", fmt.Sprintf("%.2f", before.Sueldo), fmt.Sprintf("%.2f", after.Sueldo))
+	printChange("Comisión", fmt.Sprintf("%.2f", before.Comision), fmt.Sprintf("%.2f", after.Comision))
+	if changes == 0 {
+		fmt.Println("  (sin cambios en los datos visibles)")
+	}
+}
+
+func optionalText(s *string, empty string) string {
+	if s == nil {
+		return empty
+	}
+	return *s
+}
@@ -1,22 +1,24 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"hr-system/hrclient"
 	"hr-system/shared"
 )
 
 func (c *Client) HandleInsert() {
 	fmt.Println("\n--- CREAR EMPLEADO ---")
 
-	primerNombre := c.ReadValidatedName("Primer nombre: ", true)
-	segundoNombre := c.ReadValidatedName("Segundo nombre (opcional): ", false)
+	primerNombre := c.ReadPrimerNombre("Primer nombre: ")
+	segundoNombre := c.ReadSegundoNombre("Segundo nombre (opcional): ")
 	email := c.ReadEmailInput("Email: ")
 	fechaNac := c.ReadDateInput("Fecha de nacimiento (YYYY-MM-DD): ")
-	sueldo := c.ReadPositiveFloatInput("Sueldo: ")
-	comision := c.ReadRangeFloatInput("Comisión (%): ", 0, 100)
+	sueldo := c.ReadSueldoInput("Sueldo: ")
+	comision := c.ReadComisionInput("Comisión (%): ")
 
 	cargoID, err := c.SelectCargoFromList()
 	if err != nil {
@@ -37,7 +39,7 @@ func (c *Client) HandleInsert() {
 	}
 
 	dto := shared.CreateEmpleadoDTO{
-		PrimerNombre:  *primerNombre,
+		PrimerNombre:  primerNombre,
 		SegundoNombre: segundoNombre,
 		Email:         email,
 		FechaNac:      fechaNac,
@@ -48,18 +50,18 @@ func (c *Client) HandleInsert() {
 		DptoID:        dptoID,
 	}
 
-	req := shared.Request{
-		Operation: "INSERT",
-		Data:      dto,
+	if err := shared.ValidateCreateEmpleado(dto); err != nil {
+		fmt.Printf("Datos inválidos: %v\n", err)
+		return
 	}
 
-	response, err := c.SendRequest(req)
+	result, err := c.api.CreateEmpleado(context.Background(), dto)
 	if err != nil {
-		fmt.Printf("Error enviando petición: %v\n", err)
+		c.PrintError(err)
 		return
 	}
 
-	c.PrintResponse(response)
+	c.PrintResult("Empleado creado exitosamente", result)
 }
 
 func (c *Client) HandleUpdate() {
@@ -75,7 +77,11 @@ func (c *Client) HandleUpdate() {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
-	fmt.Printf("\nDatos actuales del empleado:\n")
+	c.EditEmpleado(currentData)
+}
+
+func (c *Client) EditEmpleado(currentData *shared.EmpleadoDetailResponseDTO) {
+	fmt.Printf("\nDatos actuales del empleado (versión %d):\n", currentData.Version)
 	fmt.Printf("1. Primer nombre: %s\n", currentData.PrimerNombre)
 	if currentData.SegundoNombre != nil {
 		fmt.Printf("2. Segundo nombre: %s\n", *currentData.SegundoNombre)
@@ -95,151 +101,129 @@ func (c *Client) HandleUpdate() {
 	fmt.Printf("9. Comisión: %.2f\n", currentData.Comision)
 	campos := c.ReadInput("\n¿Qué campos desea actualizar? (ej: 1,3,5 o 'todo'): ")
 	if strings.ToLower(strings.TrimSpace(campos)) == "todo" {
-		c.UpdateAllFields(empleadoID)
+		c.UpdateAllFields(currentData)
 		return
 	}
-	c.UpdateSelectedFields(empleadoID, campos, currentData)
+	c.UpdateSelectedFields(currentData, campos)
 }
 
 func (c *Client) GetEmpleadoActual(empleadoID int) (*shared.EmpleadoDetailResponseDTO, error) {
-	dto := shared.SelectEmpleadoDTO{
-		ID: empleadoID,
-	}
-
-	req := shared.Request{
-		Operation: "SELECT",
-		Data:      dto,
-	}
-
-	response, err := c.SendRequest(req)
+	empleado, err := c.api.GetEmpleado(context.Background(), empleadoID)
 	if err != nil {
 		return nil, err
 	}
 
-	if !response.Success {
-		return nil, fmt.Errorf(response.Message)
-	}
-
-	dataBytes, _ := json.Marshal(response.Data)
-	var empleado shared.EmpleadoDetailResponseDTO
-	err = json.Unmarshal(dataBytes, &empleado)
-	if err != nil {
-		return nil, fmt.Errorf("error procesando datos del empleado: %v", err)
-	}
-
 	if empleado.IsDeleted {
-		return nil, fmt.Errorf("no se puede actualizar un empleado eliminado")
+		return nil, fmt.Errorf("el empleado está eliminado")
 	}
 
+	return trimFechaNac(empleado), nil
+}
+
+// trimFechaNac deja la fecha de nacimiento en formato YYYY-MM-DD.
+func trimFechaNac(empleado *shared.EmpleadoDetailResponseDTO) *shared.EmpleadoDetailResponseDTO {
 	if len(empleado.FechaNac) > 10 {
 		empleado.FechaNac = empleado.FechaNac[:10]
 	}
-
-	return &empleado, nil
+	return empleado
 }
 
-func (c *Client) UpdateSelectedFields(empleadoID int, campos string, current *shared.EmpleadoDetailResponseDTO) {
+func (c *Client) UpdateSelectedFields(current *shared.EmpleadoDetailResponseDTO, campos string) {
 	fieldsToUpdate := strings.Split(campos, ",")
 
-	primerNombre := current.PrimerNombre
-	var segundoNombre *string = current.SegundoNombre
-	email := current.Email
-	fechaNac := current.FechaNac
-	sueldo := current.Sueldo
-	comision := current.Comision
-
-	cargoID := 0
-	gerenteID := current.GerenteNombre
-	dptoID := 0
+	dto := shared.PatchEmpleadoDTO{
+		ID:      current.ID,
+		Version: current.Version,
+	}
 
 	for _, field := range fieldsToUpdate {
 		switch strings.TrimSpace(field) {
 		case "1":
-			primerNombre = *c.ReadValidatedName("Nuevo primer nombre: ", true)
+			primerNombre := c.ReadPrimerNombre("Nuevo primer nombre: ")
+			dto.PrimerNombre = &primerNombre
 		case "2":
-			segundoNombre = c.ReadValidatedName("Nuevo segundo nombre (opcional): ", false)
+			dto.SegundoNombre = c.ReadSegundoNombre("Nuevo segundo nombre (vacío para quitarlo): ")
+			dto.LimpiarSegundoNombre = dto.SegundoNombre == nil
 		case "3":
-			email = c.ReadEmailInput("Nuevo email: ")
+			email := c.ReadEmailInput("Nuevo email: ")
+			dto.Email = &email
 		case "4":
-			fechaNac = c.ReadDateInput("Nueva fecha de nacimiento (YYYY-MM-DD): ")
+			fechaNac := c.ReadDateInput("Nueva fecha de nacimiento (YYYY-MM-DD): ")
+			dto.FechaNac = &fechaNac
 		case "5":
-			var err error
-			cargoID, err = c.SelectCargoFromList()
+			cargoID, err := c.SelectCargoFromList()
 			if err != nil {
 				fmt.Printf("Error obteniendo cargos: %v\n", err)
 				return
 			}
+			dto.CargoID = &cargoID
 		case "6":
-			var err error
-			dptoID, err = c.SelectDepartamentoFromList()
+			dptoID, err := c.SelectDepartamentoFromList()
 			if err != nil {
 				fmt.Printf("Error obteniendo departamentos: %v\n", err)
 				return
 			}
+			dto.DptoID = &dptoID
 		case "7":
-			var err error
-			gerenteID, err = c.SelectGerenteFromListForUpdate()
+			gerenteID, err := c.SelectGerenteFromList()
 			if err != nil {
 				fmt.Printf("Error obteniendo gerentes: %v\n", err)
 				return
 			}
+			dto.GerenteID = gerenteID
+			dto.LimpiarGerente = gerenteID == nil
 		case "8":
-			sueldo = c.ReadPositiveFloatInput("Nuevo sueldo: ")
+			sueldo := c.ReadSueldoInput("Nuevo sueldo: ")
+			dto.Sueldo = &sueldo
 		case "9":
-			comision = c.ReadRangeFloatInput("Nueva comisión (%): ", 0, 100)
+			comision := c.ReadComisionInput("Nueva comisión (%): ")
+			dto.Comision = &comision
 		default:
 			fmt.Printf("Campo '%s' no válido. Use números del 1-9.\n", field)
 			return
 		}
 	}
 
-	if cargoID == 0 {
-		cargoID = c.GetCargoIDByName(current.CargoNombre)
-	}
-	if dptoID == 0 {
-		dptoID = c.GetDptoIDByName(current.DepartamentoNombre)
-	}
-
-	var finalGerenteID *int
-	if gerenteID != nil && *gerenteID != "" {
-		id := c.GetGerenteIDByName(*gerenteID)
-		finalGerenteID = &id
+	if err := shared.ValidatePatchEmpleado(dto); err != nil {
+		fmt.Printf("Datos inválidos: %v\n", err)
+		return
 	}
 
-	dto := shared.UpdateEmpleadoDTO{
-		ID:            empleadoID,
-		PrimerNombre:  primerNombre,
-		SegundoNombre: segundoNombre,
-		Email:         email,
-		FechaNac:      fechaNac,
-		Sueldo:        sueldo,
-		Comision:      comision,
-		CargoID:       cargoID,
-		GerenteID:     finalGerenteID,
-		DptoID:        dptoID,
-	}
+	for {
+		result, err := c.api.PatchEmpleado(context.Background(), dto)
+		if err == nil {
+			c.PrintResult("Empleado actualizado parcialmente", result)
+			return
+		}
 
-	req := shared.Request{
-		Operation: "UPDATE",
-		Data:      dto,
-	}
+		var conflict *hrclient.Error
+		if !errors.As(err, &conflict) || !errors.Is(err, hrclient.ErrConflict) {
+			c.PrintError(err)
+			return
+		}
 
-	response, err := c.SendRequest(req)
-	if err != nil {
-		fmt.Printf("Error enviando petición: %v\n", err)
-		return
+		latest, accion := c.ResolveConflict(current, conflict, "Combinar: aplicar solo mis cambios sobre la versión actual")
+		switch accion {
+		case ConflictApply:
+			dto.Version = latest.Version
+			current = latest
+		case ConflictRetry:
+			c.EditEmpleado(latest)
+			return
+		default:
+			fmt.Println("Operación cancelada")
+			return
+		}
 	}
-
-	c.PrintResponse(response)
 }
 
-func (c *Client) UpdateAllFields(empleadoID int) {
-	primerNombre := c.ReadValidatedName("Primer nombre: ", true)
-	segundoNombre := c.ReadValidatedName("Segundo nombre (opcional): ", false)
+func (c *Client) UpdateAllFields(current *shared.EmpleadoDetailResponseDTO) {
+	primerNombre := c.ReadPrimerNombre("Primer nombre: ")
+	segundoNombre := c.ReadSegundoNombre("Segundo nombre (opcional): ")
 	email := c.ReadEmailInput("Email: ")
 	fechaNac := c.ReadDateInput("Fecha de nacimiento (YYYY-MM-DD): ")
-	sueldo := c.ReadPositiveFloatInput("Sueldo: ")
-	comision := c.ReadRangeFloatInput("Comisión (%): ", 0, 100)
+	sueldo := c.ReadSueldoInput("Sueldo: ")
+	comision := c.ReadComisionInput("Comisión (%): ")
 
 	cargoID, err := c.SelectCargoFromList()
 	if err != nil {
@@ -260,8 +244,8 @@ func (c *Client) UpdateAllFields(empleadoID int) {
 	}
 
 	dto := shared.UpdateEmpleadoDTO{
-		ID:            empleadoID,
-		PrimerNombre:  *primerNombre,
+		ID:            current.ID,
+		PrimerNombre:  primerNombre,
 		SegundoNombre: segundoNombre,
 		Email:         email,
 		FechaNac:      fechaNac,
@@ -270,20 +254,40 @@ func (c *Client) UpdateAllFields(empleadoID int) {
 		CargoID:       cargoID,
 		GerenteID:     gerenteID,
 		DptoID:        dptoID,
+		Version:       current.Version,
 	}
 
-	req := shared.Request{
-		Operation: "UPDATE",
-		Data:      dto,
-	}
-
-	response, err := c.SendRequest(req)
-	if err != nil {
-		fmt.Printf("Error enviando petición: %v\n", err)
+	if err := shared.ValidateUpdateEmpleado(dto); err != nil {
+		fmt.Printf("Datos inválidos: %v\n", err)
 		return
 	}
 
-	c.PrintResponse(response)
+	for {
+		result, err := c.api.UpdateEmpleado(context.Background(), dto)
+		if err == nil {
+			c.PrintResult("Empleado actualizado exitosamente", result)
+			return
+		}
+
+		var conflict *hrclient.Error
+		if !errors.As(err, &conflict) || !errors.Is(err, hrclient.ErrConflict) {
+			c.PrintError(err)
+			return
+		}
+
+		latest, accion := c.ResolveConflict(current, conflict, "Sobrescribir la versión actual con todos mis datos")
+		switch accion {
+		case ConflictApply:
+			dto.Version = latest.Version
+			current = latest
+		case ConflictRetry:
+			c.EditEmpleado(latest)
+			return
+		default:
+			fmt.Println("Operación cancelada")
+			return
+		}
+	}
 }
 
 func (c *Client) HandleSelect() {
@@ -295,22 +299,13 @@ func (c *Client) HandleSelect() {
 		return
 	}
 
-	dto := shared.SelectEmpleadoDTO{
-		ID: empleadoID,
-	}
-
-	req := shared.Request{
-		Operation: "SELECT",
-		Data:      dto,
-	}
-
-	response, err := c.SendRequest(req)
+	empleado, err := c.api.GetEmpleado(context.Background(), empleadoID)
 	if err != nil {
-		fmt.Printf("Error enviando petición: %v\n", err)
+		c.PrintError(err)
 		return
 	}
 
-	c.PrintResponse(response)
+	c.PrintResult("Empleado encontrado", trimFechaNac(empleado))
 }
 
 func (c *Client) HandleDelete() {
@@ -322,26 +317,45 @@ func (c *Client) HandleDelete() {
 		return
 	}
 
+	current, err := c.GetEmpleadoActual(empleadoID)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Empleado: %s - %s\n", current.PrimerNombre, current.Email)
 	confirmacion := c.ReadInput("¿Está seguro? (s/N): ")
 	if strings.ToLower(confirmacion) != "s" {
 		fmt.Println("Operación cancelada")
 		return
 	}
 
-	dto := shared.DeleteEmpleadoDTO{
-		ID: empleadoID,
-	}
+	for {
+		err := c.api.DeleteEmpleado(context.Background(), empleadoID, current.Version)
+		if err == nil {
+			c.PrintResult("Empleado eliminado exitosamente y guardado en histórico", nil)
+			return
+		}
 
-	req := shared.Request{
-		Operation: "DELETE",
-		Data:      dto,
-	}
+		var conflict *hrclient.Error
+		if !errors.As(err, &conflict) || !errors.Is(err, hrclient.ErrConflict) {
+			c.PrintError(err)
+			return
+		}
 
-	response, err := c.SendRequest(req)
-	if err != nil {
-		fmt.Printf("Error enviando petición: %v\n", err)
-		return
+		latest, err := conflict.Current()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		trimFechaNac(latest)
+		fmt.Printf("\n%s\n", conflict.Message)
+		c.PrintEmpleadoChanges(current, latest)
+		confirmacion := c.ReadInput("¿Desea eliminarlo de todas formas? (s/N): ")
+		if strings.ToLower(confirmacion) != "s" {
+			fmt.Println("Operación cancelada")
+			return
+		}
+		current = latest
 	}
-
-	c.PrintResponse(response)
 }
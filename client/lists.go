@@ -1,110 +1,13 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"hr-system/shared"
 	"strconv"
 )
 
-type CargoConDatosDTO struct {
-	ID     int    `json:"cargo_id"`
-	Nombre string `json:"cargo_nombre"`
-}
-
-type DepartamentoConDatosDTO struct {
-	ID        int    `json:"dpto_id"`
-	Nombre    string `json:"dpto_nombre"`
-	Direccion string `json:"direccion"`
-	Ciudad    string `json:"ciudad"`
-}
-
-func (c *Client) GetCargos() ([]shared.CargoDTO, error) {
-	req := shared.Request{
-		Operation: "LIST_CARGOS",
-		Data:      nil,
-	}
-	response, err := c.SendRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	if !response.Success {
-		return nil, fmt.Errorf(response.Message)
-	}
-	dataBytes, _ := json.Marshal(response.Data)
-	var cargos []shared.CargoDTO
-	err = json.Unmarshal(dataBytes, &cargos)
-	if err != nil {
-		return nil, fmt.Errorf("error procesando lista de cargos: %v", err)
-	}
-	return cargos, nil
-}
-
-func (c *Client) GetDepartamentos() ([]shared.DepartamentoDTO, error) {
-	req := shared.Request{
-		Operation: "LIST_DEPARTAMENTOS",
-		Data:      nil,
-	}
-	response, err := c.SendRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	if !response.Success {
-		return nil, fmt.Errorf(response.Message)
-	}
-	dataBytes, _ := json.Marshal(response.Data)
-	var departamentos []shared.DepartamentoDTO
-	err = json.Unmarshal(dataBytes, &departamentos)
-	if err != nil {
-		return nil, fmt.Errorf("error procesando lista de departamentos: %v", err)
-	}
-	return departamentos, nil
-}
-
-func (c *Client) GetDepartamentosConDatos() ([]DepartamentoConDatosDTO, error) {
-	req := shared.Request{
-		Operation: "LIST_DEPARTAMENTOS_CON_DATOS",
-		Data:      nil,
-	}
-	response, err := c.SendRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	if !response.Success {
-		return nil, fmt.Errorf(response.Message)
-	}
-	dataBytes, _ := json.Marshal(response.Data)
-	var departamentos []DepartamentoConDatosDTO
-	err = json.Unmarshal(dataBytes, &departamentos)
-	if err != nil {
-		return nil, fmt.Errorf("error procesando lista de departamentos con datos: %v", err)
-	}
-	return departamentos, nil
-}
-
-func (c *Client) GetGerentes() ([]shared.GerenteDTO, error) {
-	req := shared.Request{
-		Operation: "LIST_GERENTES",
-		Data:      nil,
-	}
-	response, err := c.SendRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	if !response.Success {
-		return nil, fmt.Errorf(response.Message)
-	}
-	dataBytes, _ := json.Marshal(response.Data)
-	var gerentes []shared.GerenteDTO
-	err = json.Unmarshal(dataBytes, &gerentes)
-	if err != nil {
-		return nil, fmt.Errorf("error procesando lista de gerentes: %v", err)
-	}
-	return gerentes, nil
-}
-
 func (c *Client) SelectCargoFromList() (int, error) {
-	cargosConDatos, err := c.GetCargos()
+	cargosConDatos, err := c.api.ListCargos(context.Background())
 	if err != nil {
 		return 0, fmt.Errorf("error obteniendo cargos: %v", err)
 	}
@@ -128,7 +31,7 @@ func (c *Client) SelectCargoFromList() (int, error) {
 }
 
 func (c *Client) SelectDepartamentoFromList() (int, error) {
-	dptosConDatos, err := c.GetDepartamentosConDatos()
+	dptosConDatos, err := c.api.ListDepartamentosConDatos(context.Background())
 	if err != nil {
 		return 0, fmt.Errorf("error obteniendo departamentos: %v", err)
 	}
@@ -152,7 +55,7 @@ func (c *Client) SelectDepartamentoFromList() (int, error) {
 }
 
 func (c *Client) SelectGerenteFromList() (*int, error) {
-	gerentes, err := c.GetGerentes()
+	gerentes, err := c.api.ListGerentes(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("error obteniendo gerentes: %v", err)
 	}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -10,50 +13,90 @@ import (
 	"strconv"
 	"strings"
 
+	"hr-system/hrclient"
 	"hr-system/shared"
 )
 
 type Client struct {
-	conn net.Conn
+	api       *hrclient.Client
+	address   string
+	usuario   string
+	tlsConfig *tls.Config
 }
 
-func NewClient() *Client {
-	return &Client{}
-}
-
-func (c *Client) Connect(host, port string) error {
-	address := net.JoinHostPort(host, port)
-	conn, err := net.Dial("tcp", address)
-	if err != nil {
-		return fmt.Errorf("error conectando al servidor: %v", err)
-	}
-
-	c.conn = conn
-	log.Printf("Conectado al servidor %s", address)
-	return nil
+func NewClient(host, port string) *Client {
+	return &Client{address: net.JoinHostPort(host, port)}
 }
 
 func (c *Client) Disconnect() {
-	if c.conn != nil {
-		c.conn.Close()
+	if c.api != nil {
+		c.api.Close()
 		log.Println("Desconectado del servidor")
 	}
 }
 
-func (c *Client) SendRequest(req shared.Request) (*shared.Response, error) {
-	encoder := json.NewEncoder(c.conn)
-	decoder := json.NewDecoder(c.conn)
-
-	if err := encoder.Encode(req); err != nil {
-		return nil, fmt.Errorf("error enviando request: %v", err)
+// connect abre la sesión con las credenciales dadas. Si tiene éxito, el
+// cliente queda listo para usarse y las sesiones que expiren se renuevan con
+// las mismas credenciales.
+func (c *Client) connect(usuario, password string) (*shared.LoginResponseDTO, error) {
+	api, err := hrclient.New(hrclient.Config{
+		Address:   c.address,
+		TLSConfig: c.tlsConfig,
+		Usuario:   usuario,
+		Password:  password,
+		MaxConns:  1,
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	var response shared.Response
-	if err := decoder.Decode(&response); err != nil {
-		return nil, fmt.Errorf("error recibiendo response: %v", err)
+	login, err := api.Login(context.Background())
+	if err != nil {
+		api.Close()
+		return nil, err
+	}
+	if c.api != nil {
+		c.api.Close()
 	}
+	c.api = api
+	log.Printf("Conectado al servidor %s", c.address)
+	return login, nil
+}
 
-	return &response, nil
+func (c *Client) Login() error {
+	const maxIntentos = 3
+	if c.tlsConfig != nil && len(c.tlsConfig.Certificates) > 0 {
+		login, err := c.connect(c.usuario, "")
+		if err == nil {
+			c.usuario = login.Usuario
+			fmt.Printf("Sesión iniciada con certificado como %s\n", c.usuario)
+			return nil
+		}
+		if !errors.Is(err, hrclient.ErrUnauthorized) {
+			return err
+		}
+		fmt.Printf("Certificado no aceptado: %v\n", err)
+	}
+	for intento := 1; intento <= maxIntentos; intento++ {
+		usuario := c.usuario
+		if usuario == "" {
+			usuario = c.ReadInput("Usuario: ")
+		} else {
+			fmt.Printf("Usuario: %s\n", usuario)
+		}
+		password := c.ReadInput("Contraseña: ")
+		_, err := c.connect(usuario, password)
+		if err == nil {
+			c.usuario = usuario
+			fmt.Printf("Sesión iniciada como %s\n", usuario)
+			return nil
+		}
+		if !errors.Is(err, hrclient.ErrUnauthorized) {
+			return err
+		}
+		fmt.Printf("Error: %v\n", err)
+		c.usuario = ""
+	}
+	return fmt.Errorf("no se pudo iniciar sesión tras %d intentos", maxIntentos)
 }
 
 func (c *Client) ShowMenu() {
@@ -78,19 +121,28 @@ func (c *Client) ReadIntInput(prompt string) (int, error) {
 	return strconv.Atoi(input)
 }
 
-func (c *Client) PrintResponse(response *shared.Response) {
+// PrintResult muestra el resultado de una operación exitosa y sus datos.
+func (c *Client) PrintResult(message string, data any) {
 	fmt.Println("\n--- RESPUESTA DEL SERVIDOR ---")
-	if response.Success {
-		fmt.Printf("Éxito: %s\n", response.Message)
-		if response.Data != nil {
-			dataJSON, _ := json.MarshalIndent(response.Data, "", "  ")
-			fmt.Printf("Datos: %s\n", string(dataJSON))
-		}
-	} else {
-		fmt.Printf("Error: %s\n", response.Message)
+	fmt.Printf("Éxito: %s\n", message)
+	if data != nil {
+		dataJSON, _ := json.MarshalIndent(data, "", "  ")
+		fmt.Printf("Datos: %s\n", string(dataJSON))
 	}
 }
 
+// PrintError muestra el error de una operación. Los errores del servidor se
+// muestran con su mensaje; los demás son fallos de la conexión.
+func (c *Client) PrintError(err error) {
+	var apiErr *hrclient.Error
+	if errors.As(err, &apiErr) {
+		fmt.Println("\n--- RESPUESTA DEL SERVIDOR ---")
+		fmt.Printf("Error: %s\n", apiErr.Message)
+		return
+	}
+	fmt.Printf("Error enviando petición: %v\n", err)
+}
+
 func (c *Client) Run() {
 	defer c.Disconnect()
 
@@ -119,13 +171,15 @@ func (c *Client) Run() {
 func main() {
 	fmt.Println("=== CLIENTE DE RECURSOS HUMANOS ===")
 
-	client := NewClient()
-
-	host := "localhost"
-	port := "8888"
+	client := NewClient("localhost", "8888")
+	tlsConfig, err := TLSOptionsFromEnv().Config()
+	if err != nil {
+		log.Fatalf("Configuración TLS inválida: %v", err)
+	}
+	client.tlsConfig = tlsConfig
 
-	if err := client.Connect(host, port); err != nil {
-		log.Fatalf("Error conectando: %v", err)
+	if err := client.Login(); err != nil {
+		log.Fatalf("Error de autenticación: %v", err)
 	}
 
 	client.Run()
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// TLSOptions configura la conexión cifrada con el servidor. Con CertFile y
+// KeyFile el cliente presenta un certificado (mTLS) y puede iniciar sesión
+// sin contraseña.
+type TLSOptions struct {
+	Enabled    bool
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string
+}
+
+// TLSOptionsFromEnv lee HR_TLS, HR_TLS_CA_FILE, HR_TLS_CERT_FILE,
+// HR_TLS_KEY_FILE y HR_TLS_SERVER_NAME. Indicar cualquier archivo habilita TLS.
+func TLSOptionsFromEnv() TLSOptions {
+	opts := TLSOptions{
+		CAFile:     os.Getenv("HR_TLS_CA_FILE"),
+		CertFile:   os.Getenv("HR_TLS_CERT_FILE"),
+		KeyFile:    os.Getenv("HR_TLS_KEY_FILE"),
+		ServerName: os.Getenv("HR_TLS_SERVER_NAME"),
+	}
+	opts.Enabled = os.Getenv("HR_TLS") == "true" || opts.CAFile != "" || opts.CertFile != ""
+	return opts
+}
+
+// Config devuelve nil si TLS no está habilitado. Sin CAFile se usan las
+// autoridades del sistema.
+func (o TLSOptions) Config() (*tls.Config, error) {
+	if !o.Enabled {
+		return nil, nil
+	}
+	config := &tls.Config{
+		ServerName: o.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if o.CAFile != "" {
+		pem, err := os.ReadFile(o.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error leyendo CA del servidor: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("el archivo %s no contiene certificados PEM válidos", o.CAFile)
+		}
+		config.RootCAs = pool
+	}
+	if o.CertFile != "" || o.KeyFile != "" {
+		if o.CertFile == "" || o.KeyFile == "" {
+			return nil, fmt.Errorf("el certificado de cliente requiere HR_TLS_CERT_FILE y HR_TLS_KEY_FILE")
+		}
+		cert, err := tls.LoadX509KeyPair(o.CertFile, o.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error cargando certificado de cliente: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
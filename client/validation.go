@@ -2,86 +2,86 @@ package main
 
 import (
 	"fmt"
-	"regexp"
 	"strconv"
-	"strings"
-	"time"
-)
-
-func (c *Client) ValidateEmail(email string) bool {
-	emailRegex := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
-	matched, _ := regexp.MatchString(emailRegex, email)
-	return matched
-}
 
-func (c *Client) ValidateDate(date string) bool {
-	_, err := time.Parse("2006-01-02", date)
-	return err == nil
-}
+	"hr-system/shared"
+)
 
 func (c *Client) ReadEmailInput(prompt string) string {
 	for {
 		email := c.ReadInput(prompt)
-		if c.ValidateEmail(email) {
-			return email
+		if err := shared.ValidateEmail(email); err != nil {
+			fmt.Printf("Email inválido (%v). Use formato: usuario@dominio.com\n", err)
+			continue
 		}
-		fmt.Printf("Email inválido. Use formato: usuario@dominio.com\n")
+		return email
 	}
 }
 
 func (c *Client) ReadDateInput(prompt string) string {
 	for {
 		date := c.ReadInput(prompt)
-		if c.ValidateDate(date) {
-			return date
+		if err := shared.ValidateFechaNac(date); err != nil {
+			fmt.Printf("Fecha inválida. Use formato: YYYY-MM-DD (ej: 1990-01-15)\n")
+			continue
 		}
-		fmt.Printf("Fecha inválida. Use formato: YYYY-MM-DD (ej: 1990-01-15)\n")
+		return date
 	}
 }
 
-func (c *Client) ReadPositiveFloatInput(prompt string) float64 {
+func (c *Client) ReadSueldoInput(prompt string) float64 {
 	for {
 		input := c.ReadInput(prompt)
 		val, err := strconv.ParseFloat(input, 64)
-		if err != nil || val <= 0 {
+		if err != nil {
 			fmt.Printf("Debe ser un número mayor a 0\n")
 			continue
 		}
+		if err := shared.ValidateSueldo(val); err != nil {
+			fmt.Printf("%v\n", err)
+			continue
+		}
 		return val
 	}
 }
 
-func (c *Client) ReadRangeFloatInput(prompt string, min, max float64) float64 {
+func (c *Client) ReadComisionInput(prompt string) float64 {
 	for {
 		input := c.ReadInput(prompt)
 		val, err := strconv.ParseFloat(input, 64)
-		if err != nil || val < min || val > max {
-			fmt.Printf("Debe ser un número entre %.2f y %.2f\n", min, max)
+		if err != nil {
+			fmt.Printf("Debe ser un número entre %d y %d\n", shared.MinComision, shared.MaxComision)
+			continue
+		}
+		if err := shared.ValidateComision(val); err != nil {
+			fmt.Printf("%v\n", err)
 			continue
 		}
 		return val
 	}
 }
 
-func (c *Client) ReadValidatedName(prompt string, required bool) *string {
+func (c *Client) ReadPrimerNombre(prompt string) string {
 	for {
 		input := c.ReadInput(prompt)
-		trimmed := strings.TrimSpace(input)
-
-		if !required && trimmed == "" {
-			return nil
-		}
-
-		if required && trimmed == "" {
-			fmt.Printf("Este campo es requerido\n")
+		if err := shared.ValidatePrimerNombre(input); err != nil {
+			fmt.Printf("%v\n", err)
 			continue
 		}
+		return input
+	}
+}
 
-		if len(trimmed) > 50 {
-			fmt.Printf("Máximo 50 caracteres permitidos\n")
+func (c *Client) ReadSegundoNombre(prompt string) *string {
+	for {
+		input := c.ReadInput(prompt)
+		if input == "" {
+			return nil
+		}
+		if err := shared.ValidateSegundoNombre(&input); err != nil {
+			fmt.Printf("%v\n", err)
 			continue
 		}
-
-		return &trimmed
+		return &input
 	}
 }
@@ -0,0 +1,310 @@
+// Package hrclient es el cliente Go del protocolo de socket del servidor de
+// recursos humanos: JSON delimitado por líneas sobre TCP o TLS.
+//
+// Un Client mantiene un pool de conexiones, cada una con su propia sesión
+// iniciada con las credenciales de Config, y puede usarse desde varias
+// goroutines. Cada operación respeta la cancelación y el plazo de su
+// contexto; si el contexto no tiene plazo se aplica Config.Timeout.
+package hrclient
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"hr-system/shared"
+)
+
+const (
+	defaultMaxConns    = 4
+	defaultTimeout     = 30 * time.Second
+	defaultDialTimeout = 10 * time.Second
+	defaultIdleTimeout = time.Minute
+)
+
+// ErrClosed indica que se usó un Client después de Close.
+var ErrClosed = errors.New("hrclient: cliente cerrado")
+
+type Config struct {
+	// Address es host:puerto del servidor.
+	Address string
+	// TLSConfig habilita TLS. Si incluye un certificado de cliente, el
+	// servidor puede aceptar la sesión sin Password.
+	TLSConfig *tls.Config
+	Usuario   string
+	Password  string
+	// MaxConns limita las conexiones abiertas a la vez; por defecto 4.
+	MaxConns int
+	// Timeout es el plazo de cada operación cuando el contexto no tiene uno;
+	// por defecto 30 segundos. Un valor negativo lo desactiva.
+	Timeout time.Duration
+	// DialTimeout limita la conexión y el LOGIN; por defecto 10 segundos.
+	DialTimeout time.Duration
+	// IdleTimeout descarta las conexiones del pool que llevan más tiempo sin
+	// usarse, antes de que el servidor las cierre por inactividad; por
+	// defecto un minuto.
+	IdleTimeout time.Duration
+}
+
+type Client struct {
+	cfg   Config
+	idle  chan *conn
+	slots chan struct{}
+
+	mu     sync.Mutex
+	closed bool
+	login  *shared.LoginResponseDTO
+}
+
+func New(cfg Config) (*Client, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("hrclient: falta la dirección del servidor")
+	}
+	if cfg.MaxConns <= 0 {
+		cfg.MaxConns = defaultMaxConns
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.DialTimeout <= 0 {
+		cfg.DialTimeout = defaultDialTimeout
+	}
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = defaultIdleTimeout
+	}
+	return &Client{
+		cfg:   cfg,
+		idle:  make(chan *conn, cfg.MaxConns),
+		slots: make(chan struct{}, cfg.MaxConns),
+	}, nil
+}
+
+// Close cierra las conexiones del pool. Las operaciones en curso terminan y
+// sus conexiones se cierran al devolverse.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	for {
+		select {
+		case cn := <-c.idle:
+			cn.Close()
+			<-c.slots
+		default:
+			return nil
+		}
+	}
+}
+
+// Login abre una conexión e inicia sesión, para comprobar las credenciales
+// antes de la primera operación. Devuelve los datos de la sesión.
+func (c *Client) Login(ctx context.Context) (*shared.LoginResponseDTO, error) {
+	cn, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.release(cn, true)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.login, nil
+}
+
+// Do envía una operación y decodifica los datos de la respuesta en out, que
+// puede ser nil. Los errores del servidor se devuelven como *Error. Si la
+// sesión de la conexión expiró, inicia sesión de nuevo y reintenta una vez.
+func (c *Client) Do(ctx context.Context, op string, data any, out any) error {
+	if c.cfg.Timeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+			defer cancel()
+		}
+	}
+	response, err := c.roundTrip(ctx, shared.Request{Operation: op, Data: data})
+	if err == nil && response.Code == shared.CodeUnauthorized {
+		response, err = c.roundTrip(ctx, shared.Request{Operation: op, Data: data})
+	}
+	if err != nil {
+		return err
+	}
+	if !response.Success {
+		return &Error{Op: op, Code: response.Code, Message: response.Message, Data: response.Data}
+	}
+	if out == nil || len(response.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(response.Data, out); err != nil {
+		return fmt.Errorf("hrclient: error decodificando la respuesta de %s: %v", op, err)
+	}
+	return nil
+}
+
+// roundTrip envía req por una conexión del pool. Una conexión cuya sesión
+// expiró se descarta para que el reintento abra otra, igual que una que el
+// servidor cerró al apagarse.
+func (c *Client) roundTrip(ctx context.Context, req shared.Request) (*wireResponse, error) {
+	cn, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := cn.roundTrip(ctx, req)
+	c.release(cn, err == nil && response.Code != shared.CodeUnauthorized && response.Code != shared.CodeShuttingDown)
+	return response, err
+}
+
+func (c *Client) acquire(ctx context.Context) (*conn, error) {
+	c.mu.Lock()
+	closed := c.closed
+	c.mu.Unlock()
+	if closed {
+		return nil, ErrClosed
+	}
+	// Se prefiere una conexión libre antes que abrir otra. Las que llevan
+	// demasiado tiempo libres se descartan y se vuelve a intentar.
+	for {
+		var cn *conn
+		select {
+		case cn = <-c.idle:
+		default:
+			select {
+			case cn = <-c.idle:
+			case c.slots <- struct{}{}:
+				cn, err := c.dial(ctx)
+				if err != nil {
+					<-c.slots
+					return nil, err
+				}
+				return cn, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		if c.fresh(cn) {
+			return cn, nil
+		}
+	}
+}
+
+// fresh indica si cn puede usarse; si pasó IdleTimeout sin usarse la cierra y
+// libera su lugar en el pool.
+func (c *Client) fresh(cn *conn) bool {
+	if time.Since(cn.lastUsed) <= c.cfg.IdleTimeout {
+		return true
+	}
+	cn.Close()
+	<-c.slots
+	return false
+}
+
+// release devuelve cn al pool, o la cierra si quedó en un estado incierto o
+// el cliente ya se cerró.
+func (c *Client) release(cn *conn, reusable bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !reusable || c.closed {
+		cn.Close()
+		<-c.slots
+		return
+	}
+	cn.lastUsed = time.Now()
+	c.idle <- cn
+}
+
+func (c *Client) dial(ctx context.Context) (*conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.DialTimeout)
+	defer cancel()
+	dialer := &net.Dialer{}
+	var nc net.Conn
+	var err error
+	if c.cfg.TLSConfig != nil {
+		config := c.cfg.TLSConfig.Clone()
+		if config.ServerName == "" {
+			config.ServerName, _, _ = net.SplitHostPort(c.cfg.Address)
+		}
+		nc, err = (&tls.Dialer{NetDialer: dialer, Config: config}).DialContext(ctx, "tcp", c.cfg.Address)
+	} else {
+		nc, err = dialer.DialContext(ctx, "tcp", c.cfg.Address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("hrclient: error conectando al servidor: %v", err)
+	}
+	cn := newConn(nc)
+	response, err := cn.roundTrip(ctx, shared.Request{
+		Operation: "LOGIN",
+		Data:      shared.LoginDTO{Usuario: c.cfg.Usuario, Password: c.cfg.Password},
+	})
+	if err != nil {
+		cn.Close()
+		return nil, err
+	}
+	if !response.Success {
+		cn.Close()
+		return nil, &Error{Op: "LOGIN", Code: response.Code, Message: response.Message, Data: response.Data}
+	}
+	var login shared.LoginResponseDTO
+	if err := json.Unmarshal(response.Data, &login); err != nil {
+		cn.Close()
+		return nil, fmt.Errorf("hrclient: error decodificando la sesión: %v", err)
+	}
+	c.mu.Lock()
+	c.login = &login
+	c.mu.Unlock()
+	return cn, nil
+}
+
+// wireResponse es shared.Response con Data sin decodificar.
+type wireResponse struct {
+	Success bool            `json:"success"`
+	Code    string          `json:"code,omitempty"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// conn es una conexión con sesión iniciada. Solo la usa una goroutine a la
+// vez: la que la sacó del pool.
+type conn struct {
+	net.Conn
+	encoder  *json.Encoder
+	decoder  *json.Decoder
+	lastUsed time.Time
+}
+
+func newConn(nc net.Conn) *conn {
+	return &conn{Conn: nc, encoder: json.NewEncoder(nc), decoder: json.NewDecoder(nc)}
+}
+
+// roundTrip escribe req y lee su respuesta dentro del plazo de ctx. Si ctx se
+// cancela a mitad de camino, la conexión queda inservible y el llamador debe
+// descartarla.
+func (cn *conn) roundTrip(ctx context.Context, req shared.Request) (*wireResponse, error) {
+	deadline, _ := ctx.Deadline()
+	cn.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() {
+		cn.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+	var response wireResponse
+	err := cn.encoder.Encode(req)
+	if err == nil {
+		err = cn.decoder.Decode(&response)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil, context.DeadlineExceeded
+		}
+		return nil, fmt.Errorf("hrclient: error en %s: %v", req.Operation, err)
+	}
+	return &response, nil
+}
@@ -0,0 +1,297 @@
+package hrclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"hr-system/shared"
+)
+
+// fakeServer habla el protocolo de socket con un manejador por operación.
+// Cada conexión debe empezar con LOGIN; la contraseña válida es "clave", y
+// "limitada" recibe RATE_LIMITED como si el servidor rechazara la conexión.
+type fakeServer struct {
+	listener net.Listener
+	handle   func(conn int, req shared.Request) shared.Response
+
+	conns   atomic.Int64
+	logins  atomic.Int64
+	mu      sync.Mutex
+	open    int
+	maxOpen int
+}
+
+func startFakeServer(t *testing.T, handle func(conn int, req shared.Request) shared.Response) *fakeServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{listener: listener, handle: handle}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			nc, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(int(s.conns.Add(1)), nc)
+		}
+	}()
+	return s
+}
+
+func (s *fakeServer) serve(id int, nc net.Conn) {
+	defer nc.Close()
+	s.mu.Lock()
+	s.open++
+	if s.open > s.maxOpen {
+		s.maxOpen = s.open
+	}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.open--
+		s.mu.Unlock()
+	}()
+	decoder := json.NewDecoder(nc)
+	encoder := json.NewEncoder(nc)
+	for {
+		var req struct {
+			Operation string          `json:"operation"`
+			Data      json.RawMessage `json:"data"`
+		}
+		if err := decoder.Decode(&req); err != nil {
+			return
+		}
+		var response shared.Response
+		if req.Operation == "LOGIN" {
+			var login shared.LoginDTO
+			json.Unmarshal(req.Data, &login)
+			switch login.Password {
+			case "limitada":
+				response = shared.Response{Success: false, Code: shared.CodeRateLimited, Message: "Demasiadas peticiones"}
+			case "clave":
+				s.logins.Add(1)
+				response = shared.Response{Success: true, Message: "ok",
+					Data: shared.LoginResponseDTO{Usuario: login.Usuario, Rol: shared.RolAdmin}}
+			default:
+				response = shared.Response{Success: false, Code: shared.CodeUnauthorized, Message: "Credenciales inválidas"}
+			}
+		} else {
+			response = s.handle(id, shared.Request{Operation: req.Operation, Data: req.Data})
+		}
+		if err := encoder.Encode(response); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeServer) client(t *testing.T, cfg Config) *Client {
+	t.Helper()
+	cfg.Address = s.listener.Addr().String()
+	if cfg.Usuario == "" {
+		cfg.Usuario = "admin"
+	}
+	if cfg.Password == "" {
+		cfg.Password = "clave"
+	}
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestClientTypedMethods(t *testing.T) {
+	server := startFakeServer(t, func(_ int, req shared.Request) shared.Response {
+		switch req.Operation {
+		case "LIST_CARGOS":
+			return shared.Response{Success: true, Data: []shared.CargoDTO{{ID: 1, Nombre: "Gerente"}}}
+		case "SELECT":
+			var dto shared.SelectEmpleadoDTO
+			json.Unmarshal(req.Data.(json.RawMessage), &dto)
+			if dto.ID != 3 {
+				return shared.Response{Success: false, Code: shared.CodeNotFound, Message: "Empleado no encontrado"}
+			}
+			return shared.Response{Success: true, Data: shared.EmpleadoDetailResponseDTO{ID: 3, PrimerNombre: "José", Version: 2}}
+		}
+		return shared.Response{Success: false, Message: "Operación no válida"}
+	})
+	c := server.client(t, Config{})
+	ctx := context.Background()
+
+	login, err := c.Login(ctx)
+	if err != nil || login.Usuario != "admin" {
+		t.Fatalf("Login: %+v %v", login, err)
+	}
+	cargos, err := c.ListCargos(ctx)
+	if err != nil || len(cargos) != 1 || cargos[0].Nombre != "Gerente" {
+		t.Fatalf("ListCargos: %+v %v", cargos, err)
+	}
+	jose, err := c.GetEmpleado(ctx, 3)
+	if err != nil || jose.PrimerNombre != "José" || jose.Version != 2 {
+		t.Fatalf("GetEmpleado: %+v %v", jose, err)
+	}
+	_, err = c.GetEmpleado(ctx, 99)
+	if !errors.Is(err, ErrNotFound) || err.Error() != "Empleado no encontrado" {
+		t.Errorf("empleado inexistente: %v", err)
+	}
+	if got := server.conns.Load(); got != 1 {
+		t.Errorf("se abrieron %d conexiones para operaciones secuenciales", got)
+	}
+}
+
+func TestClientConflictCarriesCurrentRow(t *testing.T) {
+	server := startFakeServer(t, func(_ int, req shared.Request) shared.Response {
+		return shared.Response{Success: false, Code: shared.CodeConflict, Message: "El empleado fue modificado",
+			Data: shared.EmpleadoDetailResponseDTO{ID: 3, Version: 5}}
+	})
+	c := server.client(t, Config{})
+	_, err := c.PatchEmpleado(context.Background(), shared.PatchEmpleadoDTO{ID: 3, Version: 4})
+	var apiErr *Error
+	if !errors.Is(err, ErrConflict) || !errors.As(err, &apiErr) || apiErr.Op != "PATCH" {
+		t.Fatalf("se esperaba CONFLICT: %v", err)
+	}
+	current, err := apiErr.Current()
+	if err != nil || current.Version != 5 {
+		t.Errorf("fila actual: %+v %v", current, err)
+	}
+}
+
+func TestClientBadCredentials(t *testing.T) {
+	server := startFakeServer(t, nil)
+	c := server.client(t, Config{Password: "otra", MaxConns: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// Con MaxConns 1, el segundo intento solo puede conectar si el primero
+	// liberó su conexión.
+	for i := 0; i < 2; i++ {
+		if _, err := c.ListCargos(ctx); !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("se esperaba UNAUTHORIZED: %v", err)
+		}
+	}
+}
+
+func TestClientLoginKeepsServerErrorCode(t *testing.T) {
+	server := startFakeServer(t, nil)
+	c := server.client(t, Config{Password: "limitada"})
+	_, err := c.Login(context.Background())
+	if !errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("se esperaba RATE_LIMITED: %v", err)
+	}
+}
+
+func TestClientLogsInAgainWhenSessionExpires(t *testing.T) {
+	server := startFakeServer(t, func(conn int, req shared.Request) shared.Response {
+		if conn == 1 {
+			return shared.Response{Success: false, Code: shared.CodeUnauthorized, Message: "La sesión expiró"}
+		}
+		return shared.Response{Success: true, Data: []shared.GerenteDTO{{ID: 1, Nombre: "Carlos"}}}
+	})
+	c := server.client(t, Config{})
+	gerentes, err := c.ListGerentes(context.Background())
+	if err != nil || len(gerentes) != 1 {
+		t.Fatalf("ListGerentes: %+v %v", gerentes, err)
+	}
+	if got := server.logins.Load(); got != 2 {
+		t.Errorf("se iniciaron %d sesiones", got)
+	}
+}
+
+func TestClientContextCancellation(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	server := startFakeServer(t, func(_ int, req shared.Request) shared.Response {
+		if req.Operation == "LIST_GERENTES" {
+			<-block
+		}
+		return shared.Response{Success: true, Data: []shared.CargoDTO{}}
+	})
+
+	c := server.client(t, Config{Timeout: 50 * time.Millisecond})
+	start := time.Now()
+	if _, err := c.ListGerentes(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("se esperaba el plazo por defecto: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("el plazo tardó %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if _, err := c.ListGerentes(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("se esperaba la cancelación: %v", err)
+	}
+
+	// Las conexiones interrumpidas se descartan; la siguiente operación abre
+	// una nueva.
+	if _, err := c.ListCargos(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.conns.Load(); got != 3 {
+		t.Errorf("se abrieron %d conexiones", got)
+	}
+}
+
+func TestClientDiscardsIdleConnections(t *testing.T) {
+	server := startFakeServer(t, func(_ int, req shared.Request) shared.Response {
+		return shared.Response{Success: true, Data: []shared.CargoDTO{}}
+	})
+	c := server.client(t, Config{IdleTimeout: 20 * time.Millisecond})
+	ctx := context.Background()
+	if _, err := c.ListCargos(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListCargos(ctx); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, err := c.ListCargos(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.conns.Load(); got != 2 {
+		t.Errorf("se abrieron %d conexiones", got)
+	}
+}
+
+func TestClientPoolLimitsConnections(t *testing.T) {
+	server := startFakeServer(t, func(_ int, req shared.Request) shared.Response {
+		time.Sleep(5 * time.Millisecond)
+		return shared.Response{Success: true, Data: []shared.CargoDTO{}}
+	})
+	c := server.client(t, Config{MaxConns: 2})
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = c.ListCargos(context.Background())
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	server.mu.Lock()
+	maxOpen := server.maxOpen
+	server.mu.Unlock()
+	if maxOpen > 2 || server.conns.Load() > 2 {
+		t.Errorf("%d conexiones simultáneas, %d en total", maxOpen, server.conns.Load())
+	}
+
+	c.Close()
+	if _, err := c.ListCargos(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Errorf("cliente cerrado: %v", err)
+	}
+}
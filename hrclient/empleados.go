@@ -0,0 +1,111 @@
+package hrclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"hr-system/shared"
+)
+
+// CreateEmpleado crea un empleado (INSERT).
+func (c *Client) CreateEmpleado(ctx context.Context, dto shared.CreateEmpleadoDTO) (*shared.CreateEmpleadoResponseDTO, error) {
+	var result shared.CreateEmpleadoResponseDTO
+	if err := c.Do(ctx, "INSERT", dto, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetEmpleado consulta un empleado, incluso si está eliminado (SELECT).
+func (c *Client) GetEmpleado(ctx context.Context, id int) (*shared.EmpleadoDetailResponseDTO, error) {
+	var result shared.EmpleadoDetailResponseDTO
+	if err := c.Do(ctx, "SELECT", shared.SelectEmpleadoDTO{ID: id}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateEmpleado reemplaza todos los datos de un empleado (UPDATE). Si
+// dto.Version no es la actual devuelve un *Error con código CONFLICT.
+func (c *Client) UpdateEmpleado(ctx context.Context, dto shared.UpdateEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
+	var result shared.UpdateEmpleadoResponseDTO
+	if err := c.Do(ctx, "UPDATE", dto, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PatchEmpleado modifica solo los campos presentes en dto (PATCH).
+func (c *Client) PatchEmpleado(ctx context.Context, dto shared.PatchEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
+	var result shared.UpdateEmpleadoResponseDTO
+	if err := c.Do(ctx, "PATCH", dto, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteEmpleado elimina un empleado y lo registra en el histórico (DELETE).
+func (c *Client) DeleteEmpleado(ctx context.Context, id, version int) error {
+	return c.Do(ctx, "DELETE", shared.DeleteEmpleadoDTO{ID: id, Version: version}, nil)
+}
+
+// RestoreEmpleado restaura un empleado eliminado (RESTORE).
+func (c *Client) RestoreEmpleado(ctx context.Context, id, version int) (*shared.UpdateEmpleadoResponseDTO, error) {
+	var result shared.UpdateEmpleadoResponseDTO
+	if err := c.Do(ctx, "RESTORE", shared.RestoreEmpleadoDTO{ID: id, Version: version}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) ListCargos(ctx context.Context) ([]shared.CargoDTO, error) {
+	var cargos []shared.CargoDTO
+	if err := c.Do(ctx, "LIST_CARGOS", nil, &cargos); err != nil {
+		return nil, err
+	}
+	return cargos, nil
+}
+
+func (c *Client) ListDepartamentosConDatos(ctx context.Context) ([]shared.DepartamentoConDatosDTO, error) {
+	var departamentos []shared.DepartamentoConDatosDTO
+	if err := c.Do(ctx, "LIST_DEPARTAMENTOS_CON_DATOS", nil, &departamentos); err != nil {
+		return nil, err
+	}
+	return departamentos, nil
+}
+
+// ListGerentes devuelve los empleados que pueden ser gerentes, dentro del
+// alcance del usuario de la sesión.
+func (c *Client) ListGerentes(ctx context.Context) ([]shared.GerenteDTO, error) {
+	var gerentes []shared.GerenteDTO
+	if err := c.Do(ctx, "LIST_GERENTES", nil, &gerentes); err != nil {
+		return nil, err
+	}
+	return gerentes, nil
+}
+
+func (c *Client) ListAudit(ctx context.Context, dto shared.ListAuditDTO) ([]shared.AuditEntryDTO, error) {
+	var entries []shared.AuditEntryDTO
+	if err := c.Do(ctx, "LIST_AUDIT", dto, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Batch ejecuta varios pasos en una transacción (BATCH). Si un paso falla
+// devuelve su error junto con el resultado de cada paso.
+func (c *Client) Batch(ctx context.Context, dto shared.BatchRequestDTO) (*shared.BatchResponseDTO, error) {
+	var result shared.BatchResponseDTO
+	err := c.Do(ctx, "BATCH", dto, &result)
+	var apiErr *Error
+	if errors.As(err, &apiErr) && len(apiErr.Data) > 0 {
+		if json.Unmarshal(apiErr.Data, &result) == nil {
+			return &result, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,69 @@
+package hrclient
+
+import (
+	"encoding/json"
+	"errors"
+
+	"hr-system/shared"
+)
+
+// Errores para clasificar un *Error con errors.Is según su código.
+var (
+	ErrConflict     = errors.New("hrclient: conflicto de versión")
+	ErrUnauthorized = errors.New("hrclient: sesión no válida")
+	ErrForbidden    = errors.New("hrclient: operación no permitida")
+	ErrNotFound     = errors.New("hrclient: no encontrado")
+	ErrTimeout      = errors.New("hrclient: plazo del servidor excedido")
+	ErrShuttingDown = errors.New("hrclient: el servidor se está apagando")
+	ErrRateLimited  = errors.New("hrclient: límite del servidor excedido")
+	ErrInternal     = errors.New("hrclient: error interno del servidor")
+)
+
+// Error es una respuesta fallida del servidor. Code es uno de los
+// shared.Code*, o vacío si el error no tiene un código específico.
+type Error struct {
+	Op      string
+	Code    string
+	Message string
+	// Data son los datos que acompañan al error; en un CONFLICT, la fila
+	// actual del empleado.
+	Data json.RawMessage
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrConflict:
+		return e.Code == shared.CodeConflict
+	case ErrUnauthorized:
+		return e.Code == shared.CodeUnauthorized
+	case ErrForbidden:
+		return e.Code == shared.CodeForbidden
+	case ErrNotFound:
+		return e.Code == shared.CodeNotFound
+	case ErrTimeout:
+		return e.Code == shared.CodeTimeout
+	case ErrShuttingDown:
+		return e.Code == shared.CodeShuttingDown
+	case ErrRateLimited:
+		return e.Code == shared.CodeRateLimited
+	case ErrInternal:
+		return e.Code == shared.CodeInternal
+	}
+	return false
+}
+
+// Current decodifica la fila actual del empleado que devuelve un CONFLICT.
+func (e *Error) Current() (*shared.EmpleadoDetailResponseDTO, error) {
+	if e.Code != shared.CodeConflict || len(e.Data) == 0 {
+		return nil, errors.New("hrclient: el error no incluye la versión actual del empleado")
+	}
+	var empleado shared.EmpleadoDetailResponseDTO
+	if err := json.Unmarshal(e.Data, &empleado); err != nil {
+		return nil, err
+	}
+	return &empleado, nil
+}
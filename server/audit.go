@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hr-system/shared"
+	"time"
+)
+
+const (
+	AuditInsert  = "INSERT"
+	AuditUpdate  = "UPDATE"
+	AuditDelete  = "DELETE"
+	AuditRestore = "RESTORE"
+)
+
+const maxAuditLimit = 500
+
+// Actor identifica a quién se atribuyen los cambios en la auditoría y qué
+// empleados puede ver (ver scopeCondition). Ocultas son las categorías de
+// shared.CamposSensibles que no puede ver y, por lo tanto, tampoco modificar.
+type Actor struct {
+	Operador string
+	Cliente  string
+	Alcance  string
+	EmplID   *int
+	Ocultas  map[string]bool
+}
+
+// recordAudit registra la operación con la fila antes y después del cambio.
+// Debe llamarse dentro de la misma transacción que el cambio.
+func (c *EmpleadoCrud) recordAudit(ctx context.Context, operacion string, emplID int, before json.RawMessage) error {
+	after, err := c.store.SnapshotEmpleado(ctx, emplID)
+	if err != nil {
+		return err
+	}
+	operador := c.actor.Operador
+	if operador == "" {
+		operador = "sistema"
+	}
+	return c.store.InsertAudit(ctx, auditRecord{
+		Operador:  operador,
+		Cliente:   c.actor.Cliente,
+		Operacion: operacion,
+		EmplID:    emplID,
+		Antes:     before,
+		Despues:   after,
+	})
+}
+
+func (c *EmpleadoCrud) ListAudit(ctx context.Context, dto shared.ListAuditDTO) ([]shared.AuditEntryDTO, error) {
+	var entries []shared.AuditEntryDTO
+	err := c.EachAudit(ctx, dto, func(entry shared.AuditEntryDTO) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	return entries, err
+}
+
+// EachAudit recorre los registros que pide dto sin cargarlos todos en
+// memoria; lo usa el stream de gRPC.
+func (c *EmpleadoCrud) EachAudit(ctx context.Context, dto shared.ListAuditDTO, fn func(shared.AuditEntryDTO) error) error {
+	filter := auditFilter{EmplID: dto.EmplID, Operador: dto.Operador, Limit: dto.Limit}
+	if dto.Desde != "" {
+		desde, err := time.Parse(shared.FechaLayout, dto.Desde)
+		if err != nil {
+			return fmt.Errorf("fecha 'desde' inválida, use YYYY-MM-DD")
+		}
+		filter.Desde = &desde
+	}
+	if dto.Hasta != "" {
+		hasta, err := time.Parse(shared.FechaLayout, dto.Hasta)
+		if err != nil {
+			return fmt.Errorf("fecha 'hasta' inválida, use YYYY-MM-DD")
+		}
+		hasta = hasta.AddDate(0, 0, 1)
+		filter.Hasta = &hasta
+	}
+	if filter.Limit <= 0 || filter.Limit > maxAuditLimit {
+		filter.Limit = maxAuditLimit
+	}
+	return c.store.EachAudit(ctx, filter, c.actor, fn)
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hr-system/shared"
+	"log"
+	"time"
+)
+
+const defaultSessionIdleTimeout = 15 * time.Minute
+
+func (s *Server) handleLogin(ctx context.Context, sess *session, data interface{}) shared.Response {
+	var dto shared.LoginDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	var user *usuarioAutenticado
+	if sess.certUsuario != "" && dto.Password == "" &&
+		(dto.Usuario == "" || dto.Usuario == sess.certUsuario) {
+		dto.Usuario = sess.certUsuario
+		user, err = s.usuarios.AuthenticateCertificate(ctx, sess.certUsuario)
+	} else {
+		user, err = s.usuarios.Authenticate(ctx, dto.Usuario, dto.Password)
+	}
+	if err != nil {
+		log.Printf("LOGIN fallido para '%s' desde %s", dto.Usuario, sess.clientAddr)
+		sess.user = nil
+		return shared.Response{
+			Success: false,
+			Code:    shared.CodeUnauthorized,
+			Message: err.Error(),
+		}
+	}
+	sess.user = user
+	sess.userGen = s.userGeneration(user.Usuario)
+	sess.lastActivity = time.Now()
+	log.Printf("✓ Usuario %s autenticado desde %s", user.Usuario, sess.clientAddr)
+	return shared.Response{
+		Success: true,
+		Message: "Sesión iniciada",
+		Data: shared.LoginResponseDTO{
+			Usuario:            user.Usuario,
+			Rol:                user.Rol,
+			Alcance:            user.Alcance,
+			EmplID:             user.EmplID,
+			InactividadMaxSegs: int(s.sessionIdleTimeout.Seconds()),
+		},
+	}
+}
+
+func (s *Server) handleLogout(sess *session) shared.Response {
+	sess.user = nil
+	return shared.Response{
+		Success: true,
+		Message: "Sesión cerrada",
+	}
+}
+
+// checkSession exige una sesión iniciada y vigente. Cada operación aceptada
+// renueva el plazo de inactividad.
+func (s *Server) checkSession(sess *session) (shared.Response, bool) {
+	if response, ok := s.validSession(sess); !ok {
+		return response, false
+	}
+	sess.lastActivity = time.Now()
+	return shared.Response{}, true
+}
+
+// validSession comprueba, sin renovar el plazo de inactividad, que la sesión
+// siga iniciada, no haya sido revocada y no haya expirado. Si no, la cierra.
+func (s *Server) validSession(sess *session) (shared.Response, bool) {
+	if sess.user == nil {
+		return shared.Response{
+			Success: false,
+			Code:    shared.CodeUnauthorized,
+			Message: "Debe iniciar sesión con LOGIN antes de realizar operaciones",
+		}, false
+	}
+	if s.userGeneration(sess.user.Usuario) != sess.userGen {
+		log.Printf("Sesión de %s revocada: el usuario fue modificado", sess.user.Usuario)
+		sess.user = nil
+		return shared.Response{
+			Success: false,
+			Code:    shared.CodeUnauthorized,
+			Message: "Sesión revocada porque cambiaron los datos del usuario, inicie sesión nuevamente",
+		}, false
+	}
+	if time.Since(sess.lastActivity) > s.sessionIdleTimeout {
+		log.Printf("Sesión de %s expirada por inactividad", sess.user.Usuario)
+		sess.user = nil
+		return shared.Response{
+			Success: false,
+			Code:    shared.CodeUnauthorized,
+			Message: "Sesión expirada por inactividad, inicie sesión nuevamente",
+		}, false
+	}
+	return shared.Response{}, true
+}
+
+// userGeneration devuelve la generación de usuario, que revokeUserSessions
+// incrementa. Una sesión iniciada con otra generación ya no es válida.
+func (s *Server) userGeneration(usuario string) uint64 {
+	s.userGenMu.Lock()
+	defer s.userGenMu.Unlock()
+	return s.userGens[usuario]
+}
+
+// revokeUserSessions invalida las sesiones de usuario tras cambiar su rol,
+// su estado o su empleado asociado, que las sesiones guardan desde LOGIN, o
+// su contraseña, que puede haberse cambiado porque alguien más la conocía.
+// Las sesiones de socket lo notan en su siguiente operación; las de token
+// se descartan ya.
+func (s *Server) revokeUserSessions(usuario string) {
+	s.userGenMu.Lock()
+	if s.userGens == nil {
+		s.userGens = make(map[string]uint64)
+	}
+	s.userGens[usuario]++
+	s.userGenMu.Unlock()
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+	for token, ts := range s.tokenSessions {
+		if ts.usuario == usuario {
+			delete(s.tokenSessions, token)
+		}
+	}
+}
+
+func (s *Server) handleCreateUsuario(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.CreateUsuarioDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	result, err := s.usuarios.Create(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Usuario creado exitosamente",
+		Data:    result,
+	}
+}
+
+func (s *Server) handleUpdateUsuario(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.UpdateUsuarioDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	result, err := s.usuarios.Update(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
+	if dto.Password != nil || dto.Rol != nil || dto.Activo != nil || dto.EmplID != nil || dto.DesvincularEmpleado {
+		s.revokeUserSessions(dto.Usuario)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Usuario actualizado exitosamente",
+		Data:    result,
+	}
+}
+
+func (s *Server) handleListUsuarios(ctx context.Context) shared.Response {
+	usuarios, err := s.usuarios.List(ctx)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Lista de usuarios obtenida",
+		Data:    usuarios,
+	}
+}
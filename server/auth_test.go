@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"hr-system/shared"
+	"testing"
+)
+
+// loginSession inicia una sesión de socket de usuario en s.
+func loginSession(t *testing.T, s *Server, usuario, password string) *session {
+	t.Helper()
+	sess := &session{clientAddr: "test"}
+	response := s.processRequest(context.Background(), sess, shared.Request{Operation: "LOGIN",
+		Data: shared.LoginDTO{Usuario: usuario, Password: password}})
+	if !response.Success {
+		t.Fatalf("LOGIN %s: %+v", usuario, response)
+	}
+	return sess
+}
+
+func TestUpdateUsuarioRevokesSessions(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	ctx := context.Background()
+	admin := loginSession(t, s, "admin", testAdminPassword)
+	response := s.processRequest(ctx, admin, shared.Request{Operation: "CREATE_USUARIO",
+		Data: shared.CreateUsuarioDTO{Usuario: "analista", Password: "Analista123!", Rol: "hr_analyst"}})
+	if !response.Success {
+		t.Fatalf("CREATE_USUARIO: %+v", response)
+	}
+
+	// loginToken abre una sesión de token como la del gateway HTTP.
+	loginToken := func(password string) string {
+		t.Helper()
+		response := s.loginToken(ctx, &session{clientAddr: "test"},
+			shared.LoginDTO{Usuario: "analista", Password: password})
+		if !response.Success {
+			t.Fatalf("LOGIN con token: %+v", response)
+		}
+		var loginDTO shared.LoginResponseDTO
+		decodeData(t, response.Data, &loginDTO)
+		return loginDTO.Token
+	}
+	update := func(dto shared.UpdateUsuarioDTO) {
+		t.Helper()
+		response := s.processRequest(ctx, admin, shared.Request{Operation: "UPDATE_USUARIO", Data: dto})
+		if !response.Success {
+			t.Fatalf("UPDATE_USUARIO: %+v", response)
+		}
+	}
+	expectRevoked := func(socket *session, token string) {
+		t.Helper()
+		expectError(t, s.processRequest(ctx, socket, shared.Request{Operation: "LIST_CARGOS"}),
+			shared.CodeUnauthorized, "Sesión revocada")
+		if s.lookupTokenSession(token) != nil {
+			t.Error("la sesión del token sigue registrada")
+		}
+		expectError(t, s.processTokenRequest(ctx, token, shared.Request{Operation: "LIST_CARGOS"}),
+			shared.CodeUnauthorized, "Debe iniciar sesión")
+	}
+
+	socket := loginSession(t, s, "analista", "Analista123!")
+	token := loginToken("Analista123!")
+	if response := s.processRequest(ctx, socket, shared.Request{Operation: "LIST_CARGOS"}); !response.Success {
+		t.Fatalf("LIST_CARGOS antes del cambio: %+v", response)
+	}
+
+	// Quien conocía la contraseña anterior pierde sus sesiones.
+	password := "Analista456!"
+	update(shared.UpdateUsuarioDTO{Usuario: "analista", Password: &password})
+	expectRevoked(socket, token)
+
+	socket = loginSession(t, s, "analista", password)
+	token = loginToken(password)
+	inactivo := false
+	update(shared.UpdateUsuarioDTO{Usuario: "analista", Activo: &inactivo})
+	expectRevoked(socket, token)
+	if response := s.processRequest(ctx, admin, shared.Request{Operation: "LIST_CARGOS"}); !response.Success {
+		t.Errorf("se revocó la sesión de otro usuario: %+v", response)
+	}
+}
+
+func TestSaveRolRevokesSessions(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	ctx := context.Background()
+	mustProcess := func(sess *session, op string, data any) {
+		t.Helper()
+		if response := s.processRequest(ctx, sess, shared.Request{Operation: op, Data: data}); !response.Success {
+			t.Fatalf("%s: %+v", op, response)
+		}
+	}
+	admin := loginSession(t, s, "admin", testAdminPassword)
+	rol := shared.RolDTO{Nombre: "auditor", Alcance: shared.AlcanceTodos, Permisos: []string{"SELECT", "LIST_CARGOS"}}
+	mustProcess(admin, "SAVE_ROL", rol)
+	mustProcess(admin, "CREATE_USUARIO", shared.CreateUsuarioDTO{Usuario: "auditora", Password: "Auditora123!", Rol: "auditor"})
+	mustProcess(admin, "CREATE_USUARIO", shared.CreateUsuarioDTO{Usuario: "analista", Password: "Analista123!", Rol: "hr_analyst"})
+	auditora := loginSession(t, s, "auditora", "Auditora123!")
+	analista := loginSession(t, s, "analista", "Analista123!")
+	if auditora.user.Alcance != shared.AlcanceTodos {
+		t.Fatalf("alcance inicial: %+v", auditora.user)
+	}
+
+	// Reducir el alcance del rol debe aplicarse ya, no en el próximo LOGIN.
+	rol.Alcance = shared.AlcancePropio
+	mustProcess(admin, "SAVE_ROL", rol)
+	expectError(t, s.processRequest(ctx, auditora, shared.Request{Operation: "LIST_CARGOS"}),
+		shared.CodeUnauthorized, "Sesión revocada")
+	mustProcess(analista, "LIST_CARGOS", nil)
+	auditora = loginSession(t, s, "auditora", "Auditora123!")
+	if auditora.user.Alcance != shared.AlcancePropio {
+		t.Errorf("alcance tras el cambio: %+v", auditora.user)
+	}
+	mustProcess(admin, "LIST_CARGOS", nil)
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"regexp"
+)
+
+const maxBatchSteps = 100
+
+var batchRefPattern = regexp.MustCompile(`^\$([A-Za-z0-9_-]+)\.([A-Za-z0-9_]+)$`)
+
+var errBatchStepFailed = errors.New("paso de BATCH fallido")
+
+func (s *Server) handleBatch(ctx context.Context, sess *session, crud *EmpleadoCrud, data interface{}) shared.Response {
+	var dto shared.BatchRequestDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	if len(dto.Steps) == 0 {
+		return shared.Response{
+			Success: false,
+			Message: "BATCH requiere al menos una operación",
+		}
+	}
+	if len(dto.Steps) > maxBatchSteps {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("BATCH admite como máximo %d operaciones", maxBatchSteps),
+		}
+	}
+	refs := make(map[string]bool)
+	for i, step := range dto.Steps {
+		if step.Operation == "BATCH" {
+			return shared.Response{
+				Success: false,
+				Message: fmt.Sprintf("paso %d: BATCH no puede anidarse", i+1),
+			}
+		}
+		if response, ok := s.authorize(ctx, sess, step.Operation); !ok {
+			response.Message = fmt.Sprintf("paso %d: %s", i+1, response.Message)
+			return response
+		}
+		if step.Ref != "" {
+			if refs[step.Ref] {
+				return shared.Response{
+					Success: false,
+					Message: fmt.Sprintf("paso %d: referencia '%s' repetida", i+1, step.Ref),
+				}
+			}
+			refs[step.Ref] = true
+		}
+	}
+
+	var results []shared.BatchStepResultDTO
+	var failed shared.BatchStepResultDTO
+	err = crud.runInTx(ctx, func(tx *EmpleadoCrud) error {
+		outputs := make(map[string]map[string]any)
+		for i, step := range dto.Steps {
+			result := shared.BatchStepResultDTO{
+				Index:     i + 1,
+				Ref:       step.Ref,
+				Operation: step.Operation,
+			}
+			stepData, err := resolveBatchRefs(step.Data, outputs)
+			if err != nil {
+				result.Message = err.Error()
+				results = append(results, result)
+				failed = result
+				return errBatchStepFailed
+			}
+			response := s.execute(ctx, tx, shared.Request{Operation: step.Operation, Data: stepData})
+			result.Success = response.Success
+			result.Code = response.Code
+			result.Message = response.Message
+			result.Data = response.Data
+			results = append(results, result)
+			if !response.Success {
+				failed = result
+				return errBatchStepFailed
+			}
+			if step.Ref != "" {
+				output, err := toJSONMap(response.Data)
+				if err != nil {
+					return fmt.Errorf("paso %d: %v", i+1, err)
+				}
+				outputs[step.Ref] = output
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		response := shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("BATCH revertido: %v", err),
+			Data:    shared.BatchResponseDTO{Committed: false, Steps: results},
+		}
+		if errors.Is(err, errBatchStepFailed) {
+			response.Code = failed.Code
+			response.Message = fmt.Sprintf("BATCH revertido: paso %d (%s) falló: %s",
+				failed.Index, failed.Operation, failed.Message)
+		}
+		return response
+	}
+	return shared.Response{
+		Success: true,
+		Message: fmt.Sprintf("BATCH ejecutado: %d operaciones confirmadas", len(results)),
+		Data:    shared.BatchResponseDTO{Committed: true, Steps: results},
+	}
+}
+
+// resolveBatchRefs reemplaza los valores "$ref.campo" de data por el campo
+// correspondiente del resultado de un paso anterior.
+func resolveBatchRefs(data any, outputs map[string]map[string]any) (any, error) {
+	switch v := data.(type) {
+	case string:
+		match := batchRefPattern.FindStringSubmatch(v)
+		if match == nil {
+			return v, nil
+		}
+		output, ok := outputs[match[1]]
+		if !ok {
+			return nil, fmt.Errorf("referencia '%s' desconocida o aún no ejecutada", match[1])
+		}
+		value, ok := output[match[2]]
+		if !ok {
+			return nil, fmt.Errorf("el resultado de '%s' no tiene el campo '%s'", match[1], match[2])
+		}
+		return value, nil
+	case map[string]any:
+		resolved := make(map[string]any, len(v))
+		for key, item := range v {
+			r, err := resolveBatchRefs(item, outputs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = r
+		}
+		return resolved, nil
+	case []any:
+		resolved := make([]any, len(v))
+		for i, item := range v {
+			r, err := resolveBatchRefs(item, outputs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = r
+		}
+		return resolved, nil
+	default:
+		return v, nil
+	}
+}
+
+func toJSONMap(data any) (map[string]any, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var output map[string]any
+	if err := json.Unmarshal(jsonData, &output); err != nil {
+		return nil, fmt.Errorf("el resultado no puede referenciarse: %v", err)
+	}
+	return output, nil
+}
@@ -1,371 +1,304 @@
 package main
 
 import (
-	"database/sql"
+	"context"
 	"errors"
 	"fmt"
 	"hr-system/shared"
-	"regexp"
-	"strings"
-	"time"
 )
 
 type EmpleadoCrud struct {
-	db *sql.DB
+	store EmpleadoStore
+	actor Actor
 }
 
-func NewEmpleadoCrud(db *sql.DB) *EmpleadoCrud {
-	return &EmpleadoCrud{db: db}
+func NewEmpleadoCrud(store EmpleadoStore) *EmpleadoCrud {
+	return &EmpleadoCrud{store: store}
 }
 
-func (c *EmpleadoCrud) validateCreateEmpleado(dto shared.CreateEmpleadoDTO) error {
-	if strings.TrimSpace(dto.PrimerNombre) == "" {
-		return fmt.Errorf("primer nombre es requerido")
-	}
-	if len(dto.PrimerNombre) > 50 {
-		return fmt.Errorf("primer nombre no puede exceder 50 caracteres")
-	}
-	if dto.SegundoNombre != nil && len(*dto.SegundoNombre) > 50 {
-		return fmt.Errorf("segundo nombre no puede exceder 50 caracteres")
-	}
-	if strings.TrimSpace(dto.Email) == "" {
-		return fmt.Errorf("email es requerido")
-	}
-	if len(dto.Email) > 100 {
-		return fmt.Errorf("email no puede exceder 100 caracteres")
-	}
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-	if !emailRegex.MatchString(dto.Email) {
-		return fmt.Errorf("formato de email inválido")
+// WithActor devuelve un EmpleadoCrud que registra sus cambios en la
+// auditoría a nombre de actor.
+func (c *EmpleadoCrud) WithActor(actor Actor) *EmpleadoCrud {
+	return &EmpleadoCrud{store: c.store, actor: actor}
+}
+
+// runInTx ejecuta fn en una transacción. Si c ya está dentro de una, fn se
+// ejecuta en ella y el commit queda a cargo de quien la abrió.
+func (c *EmpleadoCrud) runInTx(ctx context.Context, fn func(tx *EmpleadoCrud) error) error {
+	return c.store.RunInTx(ctx, func(store EmpleadoStore) error {
+		return fn(&EmpleadoCrud{store: store, actor: c.actor})
+	})
+}
+
+func (c *EmpleadoCrud) Insert(ctx context.Context, dto shared.CreateEmpleadoDTO) (*shared.CreateEmpleadoResponseDTO, error) {
+	var response *shared.CreateEmpleadoResponseDTO
+	err := c.runInTx(ctx, func(tx *EmpleadoCrud) error {
+		var err error
+		response, err = tx.insert(ctx, dto)
+		return err
+	})
+	return response, err
+}
+
+func (c *EmpleadoCrud) insert(ctx context.Context, dto shared.CreateEmpleadoDTO) (*shared.CreateEmpleadoResponseDTO, error) {
+	dto.Normalize()
+	if err := shared.ValidateCreateEmpleado(dto); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
 	}
-	if strings.TrimSpace(dto.FechaNac) == "" {
-		return fmt.Errorf("fecha de nacimiento es requerida")
+	if err := c.checkCompensacion(true); err != nil {
+		return nil, err
 	}
-	_, err := time.Parse("2006-01-02", dto.FechaNac)
+	newID, err := c.store.InsertEmpleado(ctx, dto)
 	if err != nil {
-		return fmt.Errorf("formato de fecha inválido, use YYYY-MM-DD")
-	}
-	if dto.Sueldo <= 0 {
-		return fmt.Errorf("sueldo debe ser mayor a 0")
+		return nil, err
 	}
-	if dto.Comision < 0 || dto.Comision > 100 {
-		return fmt.Errorf("comisión debe estar entre 0 y 100")
+	if err := c.checkStillInScope(ctx, newID); err != nil {
+		return nil, err
 	}
-	if dto.CargoID <= 0 {
-		return fmt.Errorf("cargo ID es requerido y debe ser mayor a 0")
+	if err := c.recordAudit(ctx, AuditInsert, newID, nil); err != nil {
+		return nil, err
 	}
-	if dto.DptoID <= 0 {
-		return fmt.Errorf("departamento ID es requerido y debe ser mayor a 0")
+	response, err := c.store.ResumenEmpleado(ctx, newID)
+	if err != nil {
+		return nil, internalError("error obteniendo detalles del empleado creado: %v", err)
 	}
-	if dto.GerenteID != nil && *dto.GerenteID <= 0 {
-		return fmt.Errorf("gerente ID debe ser mayor a 0 si se proporciona")
+	return (*shared.CreateEmpleadoResponseDTO)(response), nil
+}
+
+func (c *EmpleadoCrud) Update(ctx context.Context, dto shared.UpdateEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
+	var response *shared.UpdateEmpleadoResponseDTO
+	err := c.runInTx(ctx, func(tx *EmpleadoCrud) error {
+		var err error
+		response, err = tx.update(ctx, dto)
+		return err
+	})
+	return response, err
+}
+
+func (c *EmpleadoCrud) update(ctx context.Context, dto shared.UpdateEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
+	dto.Normalize()
+	if err := shared.ValidateUpdateEmpleado(dto); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
 	}
-	return nil
+	// UPDATE reemplaza todos los campos: es un PATCH que envía todos y
+	// limpia los opcionales ausentes.
+	return c.write(ctx, shared.PatchEmpleadoDTO{
+		ID:                   dto.ID,
+		PrimerNombre:         &dto.PrimerNombre,
+		SegundoNombre:        dto.SegundoNombre,
+		LimpiarSegundoNombre: dto.SegundoNombre == nil,
+		Email:                &dto.Email,
+		FechaNac:             &dto.FechaNac,
+		Sueldo:               &dto.Sueldo,
+		Comision:             &dto.Comision,
+		CargoID:              &dto.CargoID,
+		GerenteID:            dto.GerenteID,
+		LimpiarGerente:       dto.GerenteID == nil,
+		DptoID:               &dto.DptoID,
+		Version:              dto.Version,
+	})
+}
+
+func (c *EmpleadoCrud) Patch(ctx context.Context, dto shared.PatchEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
+	var response *shared.UpdateEmpleadoResponseDTO
+	err := c.runInTx(ctx, func(tx *EmpleadoCrud) error {
+		var err error
+		response, err = tx.patch(ctx, dto)
+		return err
+	})
+	return response, err
 }
 
-func (c *EmpleadoCrud) validateUpdateEmpleado(dto shared.UpdateEmpleadoDTO) error {
-	if dto.ID <= 0 {
-		return fmt.Errorf("ID del empleado es requerido y debe ser mayor a 0")
+func (c *EmpleadoCrud) patch(ctx context.Context, dto shared.PatchEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
+	dto.Normalize()
+	if err := shared.ValidatePatchEmpleado(dto); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
 	}
-	createDTO := shared.CreateEmpleadoDTO{
-		PrimerNombre:  dto.PrimerNombre,
-		SegundoNombre: dto.SegundoNombre,
-		Email:         dto.Email,
-		FechaNac:      dto.FechaNac,
-		Sueldo:        dto.Sueldo,
-		Comision:      dto.Comision,
-		CargoID:       dto.CargoID,
-		GerenteID:     dto.GerenteID,
-		DptoID:        dto.DptoID,
+	if dto.IsEmpty() {
+		return nil, fmt.Errorf("validación fallida: no se enviaron campos para actualizar")
 	}
-	return c.validateCreateEmpleado(createDTO)
+	return c.write(ctx, dto)
 }
 
-func (c *EmpleadoCrud) Insert(dto shared.CreateEmpleadoDTO) (*shared.CreateEmpleadoResponseDTO, error) {
-	if err := c.validateCreateEmpleado(dto); err != nil {
-		return nil, fmt.Errorf("validación fallida: %v", err)
+// write aplica un UPDATE o PATCH ya validado: comprueba alcance, permisos
+// sobre la compensación y versión, y registra la auditoría. UPDATE siempre
+// envía sueldo y comisión, así que requiere ver la compensación.
+func (c *EmpleadoCrud) write(ctx context.Context, dto shared.PatchEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
+	if err := c.checkScope(ctx, dto.ID); err != nil {
+		return nil, err
+	}
+	if err := c.checkCompensacion(dto.Sueldo != nil || dto.Comision != nil); err != nil {
+		return nil, err
 	}
-	query := `
-		INSERT INTO empleados (empl_primer_nombre, empl_segundo_nombre, empl_email,
-		empl_fecha_nac, empl_sueldo, empl_comision, empl_cargo_id, empl_gerente_id, empl_dpto_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING empl_id`
-	var newID int
-	err := c.db.QueryRow(query, dto.PrimerNombre, dto.SegundoNombre, dto.Email,
-		dto.FechaNac, dto.Sueldo, dto.Comision, dto.CargoID, dto.GerenteID, dto.DptoID).Scan(&newID)
+	before, err := c.store.SnapshotEmpleado(ctx, dto.ID)
 	if err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			return nil, fmt.Errorf("email ya existe en el sistema")
-		}
-		if strings.Contains(err.Error(), "foreign key constraint") {
-			return nil, fmt.Errorf("ID de cargo, gerente o departamento no válido")
-		}
-		return nil, fmt.Errorf("error insertando empleado: %v", err)
+		return nil, err
 	}
-	detailQuery := `
-		SELECT e.empl_id, e.empl_primer_nombre, e.empl_segundo_nombre, e.empl_fecha_nac,
-		       c.cargo_nombre,
-		       d.dpto_nombre,
-		       CASE WHEN g.empl_id IS NOT NULL
-		            THEN CONCAT(g.empl_primer_nombre, ' ', COALESCE(g.empl_segundo_nombre, ''))
-		            ELSE NULL
-		       END as gerente_nombre,
-		       e.empl_sueldo, e.empl_comision,
-		       l.localiz_direccion,
-		       ci.ciud_nombre
-		FROM empleados e
-		INNER JOIN cargos c ON e.empl_cargo_id = c.cargo_id
-		INNER JOIN departamentos d ON e.empl_dpto_id = d.dpto_id
-		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
-		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
-		LEFT JOIN empleados g ON e.empl_gerente_id = g.empl_id
-		WHERE e.empl_id = $1`
-	var response shared.CreateEmpleadoResponseDTO
-	err = c.db.QueryRow(detailQuery, newID).Scan(
-		&response.ID, &response.PrimerNombre, &response.SegundoNombre, &response.FechaNac,
-		&response.CargoNombre, &response.DepartamentoNombre, &response.GerenteNombre,
-		&response.Sueldo, &response.Comision, &response.Direccion, &response.Ciudad)
+	updated, err := c.store.UpdateEmpleado(ctx, dto)
 	if err != nil {
-		return nil, fmt.Errorf("error obteniendo detalles del empleado creado: %v", err)
+		return nil, err
 	}
-	return &response, nil
-}
-
-func (c *EmpleadoCrud) Update(dto shared.UpdateEmpleadoDTO) (*shared.UpdateEmpleadoResponseDTO, error) {
-	if err := c.validateUpdateEmpleado(dto); err != nil {
-		return nil, fmt.Errorf("validación fallida: %v", err)
+	if !updated {
+		return nil, c.conflictOrNotFound(ctx, dto.ID)
 	}
-	query := `
-		UPDATE empleados
-		SET empl_primer_nombre=$1, empl_segundo_nombre=$2, empl_email=$3,
-		    empl_fecha_nac=$4, empl_sueldo=$5, empl_comision=$6,
-		    empl_cargo_id=$7, empl_gerente_id=$8, empl_dpto_id=$9
-		WHERE empl_id=$10 AND is_deleted=false`
-	result, err := c.db.Exec(query, dto.PrimerNombre, dto.SegundoNombre, dto.Email,
-		dto.FechaNac, dto.Sueldo, dto.Comision, dto.CargoID, dto.GerenteID, dto.DptoID, dto.ID)
-	if err != nil {
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			return nil, fmt.Errorf("email ya existe en el sistema")
-		}
-		if strings.Contains(err.Error(), "foreign key constraint") {
-			return nil, fmt.Errorf("ID de cargo, gerente o departamento no válido")
-		}
-		return nil, fmt.Errorf("error actualizando empleado: %v", err)
+	if err := c.checkStillInScope(ctx, dto.ID); err != nil {
+		return nil, err
 	}
-	rowsAffected, _ := result.RowsAffected()
-	if rowsAffected == 0 {
-		return nil, fmt.Errorf("empleado no encontrado o ya está eliminado")
+	if err := c.recordAudit(ctx, AuditUpdate, dto.ID, before); err != nil {
+		return nil, err
 	}
-	detailQuery := `
-		SELECT e.empl_id, e.empl_primer_nombre, e.empl_segundo_nombre, e.empl_fecha_nac,
-		       c.cargo_nombre,
-		       d.dpto_nombre,
-		       CASE WHEN g.empl_id IS NOT NULL
-		            THEN CONCAT(g.empl_primer_nombre, ' ', COALESCE(g.empl_segundo_nombre, ''))
-		            ELSE NULL
-		       END as gerente_nombre,
-		       e.empl_sueldo, e.empl_comision,
-		       l.localiz_direccion,
-		       ci.ciud_nombre
-		FROM empleados e
-		INNER JOIN cargos c ON e.empl_cargo_id = c.cargo_id
-		INNER JOIN departamentos d ON e.empl_dpto_id = d.dpto_id
-		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
-		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
-		LEFT JOIN empleados g ON e.empl_gerente_id = g.empl_id
-		WHERE e.empl_id = $1`
-	var response shared.UpdateEmpleadoResponseDTO
-	err = c.db.QueryRow(detailQuery, dto.ID).Scan(
-		&response.ID, &response.PrimerNombre, &response.SegundoNombre, &response.FechaNac,
-		&response.CargoNombre, &response.DepartamentoNombre, &response.GerenteNombre,
-		&response.Sueldo, &response.Comision, &response.Direccion, &response.Ciudad)
+	response, err := c.store.ResumenEmpleado(ctx, dto.ID)
 	if err != nil {
-		return nil, fmt.Errorf("error obteniendo detalles del empleado actualizado: %v", err)
+		return nil, internalError("error obteniendo detalles del empleado actualizado: %v", err)
 	}
-	return &response, nil
+	return response, nil
 }
 
-func (c *EmpleadoCrud) Select(id int) (*shared.EmpleadoDetailResponseDTO, error) {
+func (c *EmpleadoCrud) Select(ctx context.Context, id int) (*shared.EmpleadoDetailResponseDTO, error) {
 	if id <= 0 {
 		return nil, fmt.Errorf("ID debe ser mayor a 0")
 	}
-	query := `
-		SELECT e.empl_id, e.empl_primer_nombre, e.empl_segundo_nombre, e.empl_email,
-		       e.empl_fecha_nac, e.empl_sueldo, e.empl_comision,
-		       c.cargo_nombre,
-		       CASE WHEN g.empl_id IS NOT NULL
-		            THEN CONCAT(g.empl_primer_nombre, ' ', COALESCE(g.empl_segundo_nombre, ''))
-		            ELSE NULL
-		       END as gerente_nombre,
-		       d.dpto_nombre,
-		       l.localiz_direccion,
-		       ci.ciud_nombre,
-		       e.is_deleted
-		FROM empleados e
-		INNER JOIN cargos c ON e.empl_cargo_id = c.cargo_id
-		INNER JOIN departamentos d ON e.empl_dpto_id = d.dpto_id
-		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
-		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
-		LEFT JOIN empleados g ON e.empl_gerente_id = g.empl_id
-		WHERE e.empl_id=$1`
-	var emp shared.EmpleadoDetailResponseDTO
-	err := c.db.QueryRow(query, id).Scan(
-		&emp.ID, &emp.PrimerNombre, &emp.SegundoNombre, &emp.Email,
-		&emp.FechaNac, &emp.Sueldo, &emp.Comision,
-		&emp.CargoNombre, &emp.GerenteNombre, &emp.DepartamentoNombre,
-		&emp.Direccion, &emp.Ciudad,
-		&emp.IsDeleted)
+	emp, err := c.store.GetEmpleado(ctx, id, c.actor)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("empleado no encontrado")
-		}
-		return nil, fmt.Errorf("error consultando empleado: %v", err)
+		return nil, err
+	}
+	if emp == nil {
+		return nil, notFound("empleado no encontrado")
 	}
-	return &emp, nil
+	return emp, nil
 }
 
-func (c *EmpleadoCrud) Delete(id int) error {
+func (c *EmpleadoCrud) Delete(ctx context.Context, id, version int) error {
 	if id <= 0 {
 		return errors.New("ID debe ser mayor a 0")
 	}
-	query := `SELECT success, message FROM p_delete_empleado($1)`
-	var success bool
-	var message string
-	err := c.db.QueryRow(query, id).Scan(&success, &message)
-	if err != nil {
-		return fmt.Errorf("error ejecutando procedimiento almacenado: %v", err)
-	}
-	if !success {
-		return errors.New(message)
+	if err := shared.ValidateVersion(version); err != nil {
+		return fmt.Errorf("validación fallida: %v", err)
 	}
-	return nil
-}
-
-func (c *EmpleadoCrud) ListCargos() ([]shared.CargoDTO, error) {
-	query := `SELECT cargo_id, cargo_nombre FROM cargos ORDER BY cargo_id`
-	rows, err := c.db.Query(query)
-	if err != nil {
-		return nil, fmt.Errorf("error consultando cargos: %v", err)
-	}
-	defer rows.Close()
-	var cargos []shared.CargoDTO
-	for rows.Next() {
-		var cargo shared.CargoDTO
-		err := rows.Scan(&cargo.ID, &cargo.Nombre)
+	return c.runInTx(ctx, func(tx *EmpleadoCrud) error {
+		if err := tx.checkScope(ctx, id); err != nil {
+			return err
+		}
+		currentVersion, found, err := tx.store.LockEmpleado(ctx, id)
 		if err != nil {
-			return nil, fmt.Errorf("error escaneando cargo: %v", err)
+			return err
 		}
-		cargos = append(cargos, cargo)
-	}
-	return cargos, nil
+		if !found {
+			return notFound("Empleado no encontrado o ya está eliminado")
+		}
+		if currentVersion != version {
+			return tx.conflictOrNotFound(ctx, id)
+		}
+		before, err := tx.store.SnapshotEmpleado(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.store.DeleteEmpleado(ctx, id); err != nil {
+			return err
+		}
+		return tx.recordAudit(ctx, AuditDelete, id, before)
+	})
 }
 
-func (c *EmpleadoCrud) ListCargosConDatos() ([]map[string]any, error) {
-	query := `
-		SELECT DISTINCT c.cargo_id, c.cargo_nombre, l.localiz_direccion, ci.ciud_nombre
-		FROM cargos c
-		INNER JOIN empleados e ON c.cargo_id = e.empl_cargo_id
-		INNER JOIN departamentos d ON e.empl_dpto_id = d.dpto_id
-		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
-		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
-		ORDER BY c.cargo_id`
-
-	rows, err := c.db.Query(query)
-	if err != nil {
-		return nil, fmt.Errorf("error consultando cargos con datos: %v", err)
+// Restore revierte el borrado lógico de un empleado. El registro en
+// histórico se conserva como constancia del retiro.
+func (c *EmpleadoCrud) Restore(ctx context.Context, id, version int) (*shared.UpdateEmpleadoResponseDTO, error) {
+	if id <= 0 {
+		return nil, errors.New("ID debe ser mayor a 0")
 	}
-	defer rows.Close()
-
-	var cargos []map[string]any
-	for rows.Next() {
-		var cargoID int
-		var cargoNombre, direccion, ciudad string
-		err := rows.Scan(&cargoID, &cargoNombre, &direccion, &ciudad)
+	if err := shared.ValidateVersion(version); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
+	}
+	var response *shared.UpdateEmpleadoResponseDTO
+	err := c.runInTx(ctx, func(tx *EmpleadoCrud) error {
+		if err := tx.checkScope(ctx, id); err != nil {
+			return err
+		}
+		before, err := tx.store.SnapshotEmpleado(ctx, id)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return notFound("empleado no encontrado")
+		}
+		restored, err := tx.store.RestoreEmpleado(ctx, id, version)
 		if err != nil {
-			return nil, fmt.Errorf("error escaneando cargo con datos: %v", err)
+			return err
+		}
+		if !restored {
+			current, err := tx.Select(ctx, id)
+			if err != nil {
+				return err
+			}
+			if !current.IsDeleted {
+				return errors.New("el empleado no está eliminado")
+			}
+			return &OperationError{
+				Code:    shared.CodeConflict,
+				Message: fmt.Sprintf("el empleado fue modificado por otro usuario (versión actual %d)", current.Version),
+				Data:    current,
+			}
 		}
-		cargo := map[string]any{
-			"cargo_id":     cargoID,
-			"cargo_nombre": cargoNombre,
-			"direccion":    direccion,
-			"ciudad":       ciudad,
+		if err := tx.recordAudit(ctx, AuditRestore, id, before); err != nil {
+			return err
 		}
-		cargos = append(cargos, cargo)
+		response, err = tx.store.ResumenEmpleado(ctx, id)
+		if err != nil {
+			return internalError("error obteniendo detalles del empleado restaurado: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return cargos, nil
+	return response, nil
 }
 
-func (c *EmpleadoCrud) ListDepartamentos() ([]shared.DepartamentoDTO, error) {
-	query := `SELECT dpto_id, dpto_nombre FROM departamentos ORDER BY dpto_id`
-	rows, err := c.db.Query(query)
+// conflictOrNotFound explica por qué una escritura condicionada por versión
+// no afectó filas: el empleado no existe, está eliminado o cambió desde que
+// el cliente lo leyó. En el último caso devuelve la fila actual.
+func (c *EmpleadoCrud) conflictOrNotFound(ctx context.Context, id int) error {
+	current, err := c.Select(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("error consultando departamentos: %v", err)
+		return err
 	}
-	defer rows.Close()
-	var departamentos []shared.DepartamentoDTO
-	for rows.Next() {
-		var dpto shared.DepartamentoDTO
-		err := rows.Scan(&dpto.ID, &dpto.Nombre)
-		if err != nil {
-			return nil, fmt.Errorf("error escaneando departamento: %v", err)
-		}
-		departamentos = append(departamentos, dpto)
+	if current.IsDeleted {
+		return notFound("empleado no encontrado o ya está eliminado")
+	}
+	return &OperationError{
+		Code:    shared.CodeConflict,
+		Message: fmt.Sprintf("el empleado fue modificado por otro usuario (versión actual %d)", current.Version),
+		Data:    current,
 	}
-	return departamentos, nil
 }
 
-func (c *EmpleadoCrud) ListDepartamentosConDatos() ([]map[string]any, error) {
-	query := `
-		SELECT d.dpto_id, d.dpto_nombre, l.localiz_direccion, ci.ciud_nombre
-		FROM departamentos d
-		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
-		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
-		ORDER BY d.dpto_id`
+func (c *EmpleadoCrud) ListCargos(ctx context.Context) ([]shared.CargoDTO, error) {
+	return c.store.ListCargos(ctx)
+}
 
-	rows, err := c.db.Query(query)
-	if err != nil {
-		return nil, fmt.Errorf("error consultando departamentos con datos: %v", err)
-	}
-	defer rows.Close()
+func (c *EmpleadoCrud) ListCargosConDatos(ctx context.Context) ([]map[string]any, error) {
+	return c.store.ListCargosConDatos(ctx)
+}
 
-	var departamentos []map[string]any
-	for rows.Next() {
-		var dptoID int
-		var dptoNombre, direccion, ciudad string
-		err := rows.Scan(&dptoID, &dptoNombre, &direccion, &ciudad)
-		if err != nil {
-			return nil, fmt.Errorf("error escaneando departamento con datos: %v", err)
-		}
-		dpto := map[string]any{
-			"dpto_id":     dptoID,
-			"dpto_nombre": dptoNombre,
-			"direccion":   direccion,
-			"ciudad":      ciudad,
-		}
-		departamentos = append(departamentos, dpto)
-	}
-	return departamentos, nil
+func (c *EmpleadoCrud) ListDepartamentos(ctx context.Context) ([]shared.DepartamentoDTO, error) {
+	return c.store.ListDepartamentos(ctx)
 }
 
-func (c *EmpleadoCrud) ListGerentes() ([]shared.GerenteDTO, error) {
-	query := `
-		SELECT empl_id, CONCAT(empl_primer_nombre, ' ', COALESCE(empl_segundo_nombre, '')) as nombre_completo
-		FROM empleados
-		WHERE is_deleted=false
-		ORDER BY empl_id`
-	rows, err := c.db.Query(query)
-	if err != nil {
-		return nil, fmt.Errorf("error consultando gerentes: %v", err)
-	}
-	defer rows.Close()
+func (c *EmpleadoCrud) ListDepartamentosConDatos(ctx context.Context) ([]map[string]any, error) {
+	return c.store.ListDepartamentosConDatos(ctx)
+}
+
+func (c *EmpleadoCrud) ListGerentes(ctx context.Context) ([]shared.GerenteDTO, error) {
 	var gerentes []shared.GerenteDTO
-	for rows.Next() {
-		var gerente shared.GerenteDTO
-		err := rows.Scan(&gerente.ID, &gerente.Nombre)
-		if err != nil {
-			return nil, fmt.Errorf("error escaneando gerente: %v", err)
-		}
+	err := c.EachGerente(ctx, func(gerente shared.GerenteDTO) error {
 		gerentes = append(gerentes, gerente)
-	}
-	return gerentes, nil
+		return nil
+	})
+	return gerentes, err
+}
+
+// EachGerente recorre los gerentes del alcance del actor sin cargarlos todos
+// en memoria; lo usa el stream de gRPC.
+func (c *EmpleadoCrud) EachGerente(ctx context.Context, fn func(shared.GerenteDTO) error) error {
+	return c.store.EachGerente(ctx, c.actor, fn)
 }
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// poolConfig es el tamaño y la renovación del pool de conexiones. Renovar
+// las conexiones periódicamente hace que, tras un failover, el pool termine
+// apuntando al nuevo primario aunque el monitor no lo note.
+type poolConfig struct {
+	maxOpen     int
+	maxIdle     int
+	maxLifetime time.Duration
+	maxIdleTime time.Duration
+}
+
+func defaultPoolConfig() poolConfig {
+	return poolConfig{
+		maxOpen:     20,
+		maxIdle:     5,
+		maxLifetime: 30 * time.Minute,
+		maxIdleTime: 5 * time.Minute,
+	}
+}
+
+func (p poolConfig) apply(db *sql.DB) {
+	db.SetMaxOpenConns(p.maxOpen)
+	db.SetMaxIdleConns(p.maxIdle)
+	db.SetConnMaxLifetime(p.maxLifetime)
+	db.SetConnMaxIdleTime(p.maxIdleTime)
+}
+
+// poolConfigFromEnv lee DB_MAX_OPEN_CONNS, DB_MAX_IDLE_CONNS,
+// DB_CONN_MAX_LIFETIME y DB_CONN_MAX_IDLE_TIME. Un cero no limita, salvo en
+// DB_MAX_IDLE_CONNS, donde no conserva conexiones libres.
+func poolConfigFromEnv() (poolConfig, error) {
+	pool := defaultPoolConfig()
+	for name, target := range map[string]*int{
+		"DB_MAX_OPEN_CONNS": &pool.maxOpen,
+		"DB_MAX_IDLE_CONNS": &pool.maxIdle,
+	} {
+		if v := os.Getenv(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				return poolConfig{}, fmt.Errorf("%s inválido: %s", name, v)
+			}
+			*target = n
+		}
+	}
+	for name, target := range map[string]*time.Duration{
+		"DB_CONN_MAX_LIFETIME":  &pool.maxLifetime,
+		"DB_CONN_MAX_IDLE_TIME": &pool.maxIdleTime,
+	} {
+		if v := os.Getenv(name); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil || d < 0 {
+				return poolConfig{}, fmt.Errorf("%s inválido: %s", name, v)
+			}
+			*target = d
+		}
+	}
+	return pool, nil
+}
+
+// postgresSSLModes son los sslmode que admite lib/pq.
+var postgresSSLModes = []string{"disable", "require", "verify-ca", "verify-full"}
+
+// postgresDSN arma el DSN de Postgres con DB_HOST, DB_PORT, DB_USER,
+// DB_PASSWORD, DB_NAME, DB_SSLMODE ("disable" por defecto) y, si están
+// definidos, DB_SSLROOTCERT, DB_SSLCERT y DB_SSLKEY. DB_DSN, si está
+// definido, los reemplaza a todos.
+func postgresDSN() (string, error) {
+	if dsn := os.Getenv("DB_DSN"); dsn != "" {
+		return dsn, nil
+	}
+	sslmode := os.Getenv("DB_SSLMODE")
+	if sslmode == "" {
+		sslmode = "disable"
+	}
+	valid := false
+	for _, mode := range postgresSSLModes {
+		valid = valid || sslmode == mode
+	}
+	if !valid {
+		return "", fmt.Errorf("DB_SSLMODE no válido: %s (use %s)", sslmode, strings.Join(postgresSSLModes, ", "))
+	}
+	params := []struct{ key, env string }{
+		{"host", "DB_HOST"}, {"port", "DB_PORT"}, {"user", "DB_USER"}, {"password", "DB_PASSWORD"},
+		{"dbname", "DB_NAME"}, {"sslrootcert", "DB_SSLROOTCERT"}, {"sslcert", "DB_SSLCERT"},
+		{"sslkey", "DB_SSLKEY"},
+	}
+	var parts []string
+	for _, p := range params {
+		if v := os.Getenv(p.env); v != "" {
+			parts = append(parts, p.key+"="+dsnValue(v))
+		}
+	}
+	parts = append(parts, "sslmode="+sslmode)
+	return strings.Join(parts, " "), nil
+}
+
+// dsnValue entrecomilla un valor del DSN si tiene espacios, comillas o
+// barras invertidas, como una contraseña cualquiera.
+func dsnValue(v string) string {
+	if !strings.ContainsAny(v, ` '\`) {
+		return v
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+}
+
+const (
+	defaultDBConnectTimeout = time.Minute
+	defaultDBCheckInterval  = 15 * time.Second
+	dbRetryInitialDelay     = 500 * time.Millisecond
+	dbRetryMaxDelay         = 10 * time.Second
+	dbRecheckInterval       = 2 * time.Second
+)
+
+// waitForDB espera a que db responda, reintentando con espera exponencial
+// hasta timeout; docker-compose suele arrancar el servidor antes que
+// Postgres. Las credenciales inválidas o una base inexistente no se
+// reintentan.
+func waitForDB(ctx context.Context, db *sql.DB, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	delay := dbRetryInitialDelay
+	for attempt := 1; ; attempt++ {
+		err := db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		if permanentDBError(err) {
+			return err
+		}
+		log.Printf("Base de datos no disponible (intento %d): %v; reintentando en %v", attempt, err, delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return fmt.Errorf("la base de datos no respondió en %v (%d intentos): %v", timeout, attempt, err)
+		}
+		delay = min(2*delay, dbRetryMaxDelay)
+	}
+}
+
+// permanentDBError distingue los errores de configuración, que no se
+// resuelven esperando: autorización inválida (clase 28) y base inexistente.
+func permanentDBError(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code.Class() == "28" || pqErr.Code == "3D000"
+}
+
+// checkDB verifica que la base de datos responde y, en Postgres, que la
+// conexión no quedó en un servidor en recuperación, como un antiguo primario
+// degradado a réplica tras un failover.
+func (s *Server) checkDB(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if s.dialect != dialectPostgres {
+		return s.db.PingContext(ctx)
+	}
+	var recovery bool
+	if err := s.db.QueryRowContext(ctx, `SELECT pg_is_in_recovery()`).Scan(&recovery); err != nil {
+		return err
+	}
+	if recovery {
+		return fmt.Errorf("el servidor de base de datos está en recuperación (réplica de solo lectura)")
+	}
+	return nil
+}
+
+// monitorDB verifica la base de datos cada dbCheckInterval hasta que ctx se
+// cancela. Si la verificación falla descarta las conexiones libres del pool,
+// para que las siguientes se abran de nuevo y lleguen al primario vigente,
+// y verifica con más frecuencia hasta que se recupera.
+func (s *Server) monitorDB(ctx context.Context) {
+	healthy := true
+	for {
+		interval := s.dbCheckInterval
+		if !healthy {
+			interval = min(interval, dbRecheckInterval)
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+		err := s.checkDB(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			log.Printf("Base de datos no disponible: %v; se renuevan las conexiones", err)
+			s.resetPool()
+			healthy = false
+		case err == nil && !healthy:
+			log.Println("✓ Conexión a la base de datos restablecida")
+			healthy = true
+		}
+	}
+}
+
+// resetPool cierra las conexiones libres del pool. Las que están en uso se
+// descartan al devolverse si su consulta falló por la conexión.
+func (s *Server) resetPool() {
+	maxIdle := s.dbPool.maxIdle
+	s.db.SetMaxIdleConns(0)
+	s.db.SetMaxIdleConns(maxIdle)
+}
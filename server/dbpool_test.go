@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDBConfigFromEnv(t *testing.T) {
+	t.Setenv("DB_DRIVER", "")
+	t.Setenv("DB_HOST", "db.interna")
+	t.Setenv("DB_PORT", "5432")
+	t.Setenv("DB_USER", "hr")
+	t.Setenv("DB_PASSWORD", `con 'comillas' y \\`)
+	t.Setenv("DB_NAME", "rrhh")
+	t.Setenv("DB_SSLMODE", "verify-full")
+	t.Setenv("DB_SSLROOTCERT", "/certs/ca.pem")
+	t.Setenv("DB_MAX_OPEN_CONNS", "8")
+	t.Setenv("DB_CONN_MAX_LIFETIME", "10m")
+	t.Setenv("DB_CONNECT_TIMEOUT", "2m")
+	cfg, err := dbConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `host=db.interna port=5432 user=hr password='con \'comillas\' y \\\\' dbname=rrhh ` +
+		`sslrootcert=/certs/ca.pem sslmode=verify-full`
+	if cfg.dsn != want {
+		t.Errorf("dsn = %s", cfg.dsn)
+	}
+	if cfg.pool.maxOpen != 8 || cfg.pool.maxIdle != defaultPoolConfig().maxIdle ||
+		cfg.pool.maxLifetime != 10*time.Minute || cfg.connectTimeout != 2*time.Minute {
+		t.Errorf("configuración inesperada: %+v", cfg)
+	}
+
+	t.Setenv("DB_DSN", "postgres://hr@db.interna/rrhh?sslmode=require")
+	if cfg, err = dbConfigFromEnv(); err != nil || cfg.dsn != "postgres://hr@db.interna/rrhh?sslmode=require" {
+		t.Errorf("DB_DSN no reemplazó la configuración: %q %v", cfg.dsn, err)
+	}
+
+	t.Setenv("DB_DSN", "")
+	t.Setenv("DB_SSLMODE", "prefer")
+	if _, err := dbConfigFromEnv(); err == nil || !strings.Contains(err.Error(), "DB_SSLMODE no válido") {
+		t.Errorf("sslmode no admitido: %v", err)
+	}
+	t.Setenv("DB_SSLMODE", "")
+	t.Setenv("DB_MAX_IDLE_CONNS", "-1")
+	if _, err := dbConfigFromEnv(); err == nil || !strings.Contains(err.Error(), "DB_MAX_IDLE_CONNS") {
+		t.Errorf("tamaño de pool negativo: %v", err)
+	}
+}
+
+func TestWaitForDBRetriesUntilTimeout(t *testing.T) {
+	port, err := freePort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := sql.Open("postgres", (&testPostgres{port: port}).dsn("postgres"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	start := time.Now()
+	err = waitForDB(context.Background(), db, 800*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "(2 intentos)") {
+		t.Errorf("se esperaban dos intentos: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 800*time.Millisecond || elapsed > 5*time.Second {
+		t.Errorf("la espera duró %v", elapsed)
+	}
+}
+
+func TestCheckDBAndResetPool(t *testing.T) {
+	db := openSQLiteTestDB(t)
+	s := NewServer("0")
+	s.db, s.dialect, s.dbPool = db, dialectSQLite, defaultPoolConfig()
+	ctx := context.Background()
+	if err := s.checkDB(ctx); err != nil {
+		t.Fatal(err)
+	}
+	s.resetPool()
+	if idle := db.Stats().Idle; idle != 0 {
+		t.Errorf("quedaron %d conexiones libres", idle)
+	}
+	if err := s.checkDB(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if idle := db.Stats().Idle; idle != 1 {
+		t.Errorf("el pool no conserva conexiones tras renovarse: %d libres", idle)
+	}
+	db.Close()
+	if err := s.checkDB(ctx); err == nil {
+		t.Error("checkDB no detectó la base cerrada")
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// dialect es el motor de base de datos. Postgres es el backend principal;
+// SQLite es un backend embebido para despliegues de un solo nodo y demos.
+// Ambos tienen el esquema completo, pero SQLite no tiene LISTEN/NOTIFY: los
+// eventos se guardan en una tabla que el servidor consulta periódicamente
+// (ver pollEvents), así que llegan a los suscriptores con hasta
+// eventosPollInterval de retraso.
+type dialect string
+
+const (
+	dialectPostgres dialect = "postgres"
+	dialectSQLite   dialect = "sqlite"
+)
+
+func (d dialect) String() string {
+	if d == dialectSQLite {
+		return "SQLite"
+	}
+	return "PostgreSQL"
+}
+
+// forUpdate es la cláusula que bloquea las filas leídas hasta el fin de la
+// transacción. SQLite no la tiene: sus transacciones se abren con
+// BEGIN IMMEDIATE (ver sqliteDSN) y bloquean toda la base de datos.
+func (d dialect) forUpdate() string {
+	if d == dialectSQLite {
+		return ""
+	}
+	return " FOR UPDATE"
+}
+
+// tableExistsQuery devuelve una consulta que recibe el nombre de una tabla y
+// responde si existe.
+func (d dialect) tableExistsQuery() string {
+	if d == dialectSQLite {
+		return `SELECT EXISTS(SELECT 1 FROM sqlite_master WHERE type='table' AND name=$1)`
+	}
+	return `SELECT to_regclass($1) IS NOT NULL`
+}
+
+// now es la hora actual en SQL, comparable con una columna TIMESTAMP.
+func (d dialect) now() string {
+	if d == dialectSQLite {
+		return `strftime('%Y-%m-%d %H:%M:%f', 'now')`
+	}
+	return "NOW()"
+}
+
+// nowPlus es la hora actual más los segundos del parámetro param ("$1").
+func (d dialect) nowPlus(param string) string {
+	if d == dialectSQLite {
+		return fmt.Sprintf(`strftime('%%Y-%%m-%%d %%H:%%M:%%f', 'now', %s || ' seconds')`, param)
+	}
+	return fmt.Sprintf("NOW() + %s * INTERVAL '1 second'", param)
+}
+
+// sqliteTimeLayout es el formato UTC de las columnas TIMESTAMP en SQLite, el
+// mismo que produce strftime('%Y-%m-%d %H:%M:%f'), para que las comparaciones
+// de texto ordenen por fecha.
+const sqliteTimeLayout = "2006-01-02 15:04:05.000"
+
+// timeArg adapta un instante para compararlo con una columna TIMESTAMP.
+func (d dialect) timeArg(t time.Time) any {
+	if d == dialectSQLite {
+		return t.UTC().Format(sqliteTimeLayout)
+	}
+	return t
+}
+
+// dbConfig es la configuración de base de datos del entorno: DB_DRIVER elige
+// el backend ("postgres" por defecto o "sqlite"); Postgres usa DB_DSN o las
+// variables de postgresDSN, y SQLite el archivo DB_PATH. DB_CONNECT_TIMEOUT
+// es cuánto se espera al arrancar a que la base de datos responda.
+type dbConfig struct {
+	dialect        dialect
+	dsn            string
+	pool           poolConfig
+	connectTimeout time.Duration
+}
+
+func dbConfigFromEnv() (dbConfig, error) {
+	cfg := dbConfig{connectTimeout: defaultDBConnectTimeout}
+	switch driver := os.Getenv("DB_DRIVER"); driver {
+	case "", string(dialectPostgres):
+		dsn, err := postgresDSN()
+		if err != nil {
+			return dbConfig{}, err
+		}
+		cfg.dialect, cfg.dsn = dialectPostgres, dsn
+	case string(dialectSQLite):
+		path := os.Getenv("DB_PATH")
+		if path == "" {
+			path = "hr.db"
+		}
+		cfg.dialect, cfg.dsn = dialectSQLite, sqliteDSN(path)
+	default:
+		return dbConfig{}, fmt.Errorf("DB_DRIVER no válido: %s (use postgres o sqlite)", driver)
+	}
+	pool, err := poolConfigFromEnv()
+	if err != nil {
+		return dbConfig{}, err
+	}
+	cfg.pool = pool
+	if v := os.Getenv("DB_CONNECT_TIMEOUT"); v != "" {
+		if cfg.connectTimeout, err = parseTimeout(v); err != nil {
+			return dbConfig{}, fmt.Errorf("DB_CONNECT_TIMEOUT inválido: %v", err)
+		}
+	}
+	return cfg, nil
+}
+
+// sqliteDSN activa las claves foráneas, que SQLite no verifica por defecto,
+// espera a que se liberen los bloqueos en lugar de fallar, y abre las
+// transacciones con BEGIN IMMEDIATE para que dos escritores no se
+// interbloqueen al pasar de lectura a escritura.
+func sqliteDSN(path string) string {
+	return "file:" + path +
+		"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"
+}
+
+// openDB abre la conexión con la configuración DB_* del entorno y espera a
+// que la base de datos responda.
+func openDB(ctx context.Context) (*sql.DB, dbConfig, error) {
+	cfg, err := dbConfigFromEnv()
+	if err != nil {
+		return nil, dbConfig{}, err
+	}
+	db, err := sql.Open(string(cfg.dialect), cfg.dsn)
+	if err != nil {
+		return nil, dbConfig{}, fmt.Errorf("error conectando a la base de datos: %v", err)
+	}
+	cfg.pool.apply(db)
+	err = waitForDB(ctx, db, cfg.connectTimeout)
+	if err != nil {
+		db.Close()
+		return nil, dbConfig{}, fmt.Errorf("error haciendo ping a la base de datos: %v", err)
+	}
+	return db, cfg, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"strings"
+)
+
+// OperationError es un error de negocio con un código del protocolo
+// (shared.Code*) y, opcionalmente, datos que se devuelven al cliente.
+type OperationError struct {
+	Code    string
+	Message string
+	Data    any
+}
+
+func (e *OperationError) Error() string {
+	return e.Message
+}
+
+// notFound indica que el recurso no existe o está fuera del alcance del
+// usuario.
+func notFound(message string) error {
+	return &OperationError{Code: shared.CodeNotFound, Message: message}
+}
+
+// internalError es un fallo del servidor, no de la petición: el gateway HTTP
+// lo responde con 500 en lugar de 400.
+func internalError(format string, args ...any) error {
+	return &OperationError{Code: shared.CodeInternal, Message: fmt.Sprintf(format, args...)}
+}
+
+func errorResponse(err error) shared.Response {
+	var opErr *OperationError
+	if errors.As(err, &opErr) {
+		return shared.Response{
+			Success: false,
+			Code:    opErr.Code,
+			Message: opErr.Message,
+			Data:    opErr.Data,
+		}
+	}
+	return shared.Response{
+		Success: false,
+		Message: err.Error(),
+	}
+}
+
+// isUniqueViolation reconoce la violación de una restricción UNIQUE en los
+// mensajes de Postgres y de SQLite.
+func isUniqueViolation(err error) bool {
+	return strings.Contains(err.Error(), "duplicate key value violates unique constraint") ||
+		strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
+
+// isForeignKeyViolation reconoce la violación de una clave foránea en los
+// mensajes de Postgres y de SQLite.
+func isForeignKeyViolation(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "foreign key constraint")
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hr-system/shared"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// eventosCanal es el canal de NOTIFY de migrations/postgres/0021_eventos.up.sql.
+const eventosCanal = "hr_eventos"
+
+// defaultSubscriptionCheck es cada cuánto se revisa la sesión de una
+// conexión suscrita que no recibe eventos.
+const defaultSubscriptionCheck = 30 * time.Second
+
+// subscriptionBuffer es cuántos eventos se guardan para un suscriptor lento
+// antes de descartar los siguientes.
+const subscriptionBuffer = 256
+
+// subscription es una suscripción activa de una conexión de socket. crud
+// lleva el actor para filtrar los eventos de empleados por alcance.
+type subscription struct {
+	filter    shared.SubscribeDTO
+	crud      *EmpleadoCrud
+	events    chan shared.EventoDTO
+	descartes int
+}
+
+// eventHub reparte los eventos de la base de datos entre los suscriptores.
+type eventHub struct {
+	mu   sync.Mutex
+	subs map[*subscription]bool
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{subs: make(map[*subscription]bool)}
+}
+
+func (h *eventHub) add(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subs[sub] = true
+}
+
+func (h *eventHub) remove(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs, sub)
+}
+
+// publish nunca bloquea: si el suscriptor no consume, el evento se descarta.
+func (h *eventHub) publish(ev shared.EventoDTO) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if !sub.matches(ev) {
+			continue
+		}
+		select {
+		case sub.events <- ev:
+		default:
+			sub.descartes++
+			if sub.descartes == 1 {
+				log.Printf("Suscriptor %s lento, descartando eventos", sub.crud.actor.Cliente)
+			}
+		}
+	}
+}
+
+func (sub *subscription) matches(ev shared.EventoDTO) bool {
+	if len(sub.filter.Tipos) > 0 {
+		ok := false
+		for _, tipo := range sub.filter.Tipos {
+			entidad := strings.TrimSuffix(tipo, ".*")
+			if ev.Tipo == tipo || strings.HasPrefix(ev.Tipo, entidad+".") {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(sub.filter.DptoIDs) > 0 {
+		ok := false
+		for _, id := range sub.filter.DptoIDs {
+			if (ev.DptoID != nil && *ev.DptoID == id) || (ev.DptoAnteriorID != nil && *ev.DptoAnteriorID == id) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// visible aplica el alcance del suscriptor a los eventos de empleados. Se
+// evalúa al entregar, fuera del hub, porque consulta la base de datos.
+func (sub *subscription) visible(ctx context.Context, ev shared.EventoDTO) bool {
+	if !strings.HasPrefix(ev.Tipo, "empleado.") {
+		return true
+	}
+	if ev.ID == nil {
+		return false
+	}
+	ok, err := sub.crud.inScope(ctx, *ev.ID)
+	if err != nil {
+		log.Printf("Error verificando alcance de evento: %v", err)
+		return false
+	}
+	return ok
+}
+
+// listenEvents recibe los NOTIFY de la base de datos y los publica hasta que
+// ctx se cancela. pq reconecta el listener si se pierde la conexión.
+func (s *Server) listenEvents(ctx context.Context, connStr string) {
+	listener := pq.NewListener(connStr, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Listener de eventos: %v", err)
+		}
+	})
+	defer listener.Close()
+	if err := listener.Listen(eventosCanal); err != nil {
+		log.Printf("Error escuchando eventos: %v", err)
+		return
+	}
+	log.Println("✓ Escuchando eventos de cambios")
+	for {
+		select {
+		case n := <-listener.Notify:
+			// n es nil tras una reconexión; los eventos perdidos no se
+			// recuperan.
+			if n == nil {
+				continue
+			}
+			var ev shared.EventoDTO
+			if err := json.Unmarshal([]byte(n.Extra), &ev); err != nil {
+				log.Printf("Evento inválido: %v", err)
+				continue
+			}
+			s.events.publish(ev)
+		case <-time.After(90 * time.Second):
+			go listener.Ping()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// eventosPollInterval es cada cuánto se consulta la tabla de eventos en
+// SQLite. eventosRetencion es cuánto se guardan allí los eventos antes de
+// purgarlos; los webhooks ya los encolaron al insertarse.
+const (
+	eventosPollInterval = time.Second
+	eventosRetencion    = time.Hour
+	eventosLote         = 500
+)
+
+// pollEvents publica los eventos que los triggers de
+// migrations/sqlite/0005_eventos.up.sql guardan en la tabla eventos, hasta
+// que ctx se cancela. Como LISTEN, empieza por los eventos posteriores al
+// arranque. SQLite serializa las escrituras, así que los IDs se confirman en
+// orden y ninguno queda atrás del último leído.
+func (s *Server) pollEvents(ctx context.Context) {
+	var ultimo int64
+	if err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(evt_ID), 0) FROM eventos`).Scan(&ultimo); err != nil {
+		log.Printf("Error leyendo eventos: %v", err)
+		return
+	}
+	log.Println("✓ Consultando eventos de cambios")
+	purga := time.Now()
+	for {
+		select {
+		case <-time.After(eventosPollInterval):
+		case <-ctx.Done():
+			return
+		}
+		var err error
+		if ultimo, err = s.publishEvents(ctx, ultimo); err != nil && ctx.Err() == nil {
+			log.Printf("Error leyendo eventos: %v", err)
+		}
+		if time.Since(purga) >= eventosRetencion {
+			purga = time.Now()
+			_, err := s.db.ExecContext(ctx, `DELETE FROM eventos WHERE evt_creado < $1`,
+				s.dialect.timeArg(purga.Add(-eventosRetencion)))
+			if err != nil && ctx.Err() == nil {
+				log.Printf("Error purgando eventos: %v", err)
+			}
+		}
+	}
+}
+
+// publishEvents publica los eventos posteriores a ultimo y devuelve el ID
+// del último publicado.
+func (s *Server) publishEvents(ctx context.Context, ultimo int64) (int64, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT evt_ID, evt_evento FROM eventos WHERE evt_ID > $1 ORDER BY evt_ID LIMIT $2`,
+		ultimo, eventosLote)
+	if err != nil {
+		return ultimo, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var evento string
+		if err := rows.Scan(&ultimo, &evento); err != nil {
+			return ultimo, err
+		}
+		var ev shared.EventoDTO
+		if err := json.Unmarshal([]byte(evento), &ev); err != nil {
+			log.Printf("Evento inválido: %v", err)
+			continue
+		}
+		s.events.publish(ev)
+	}
+	return ultimo, rows.Err()
+}
+
+func (s *Server) handleSubscribe(ctx context.Context, sess *session, crud *EmpleadoCrud, data interface{}) shared.Response {
+	if !sess.streaming {
+		return shared.Response{
+			Success: false,
+			Message: "SUBSCRIBE solo está disponible en conexiones de socket",
+		}
+	}
+	sub, response := s.newSubscription(crud, data)
+	if sub == nil {
+		return response
+	}
+	sess.subscription = sub
+	return shared.Response{
+		Success: true,
+		Message: "Suscripción activa. Los eventos llegan como respuestas con el tipo en message; envíe PING para mantener la sesión y UNSUBSCRIBE para terminar",
+		Data:    sub.filter,
+	}
+}
+
+// newSubscription valida el filtro de SUBSCRIBE y prepara la suscripción
+// del actor de crud, sin registrarla en el hub. Si falla, devuelve la
+// respuesta de error.
+func (s *Server) newSubscription(crud *EmpleadoCrud, data interface{}) (*subscription, shared.Response) {
+	if s.events == nil {
+		return nil, shared.Response{
+			Success: false,
+			Message: "SUBSCRIBE no está disponible con este backend de base de datos",
+		}
+	}
+	var dto shared.SubscribeDTO
+	if data != nil {
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, shared.Response{
+				Success: false,
+				Message: fmt.Sprintf("Error procesando datos: %v", err),
+			}
+		}
+		if err := json.Unmarshal(jsonData, &dto); err != nil {
+			return nil, shared.Response{
+				Success: false,
+				Message: fmt.Sprintf("Error en formato de datos: %v", err),
+			}
+		}
+	}
+	for _, tipo := range dto.Tipos {
+		if err := shared.ValidateTipoEvento(tipo); err != nil {
+			return nil, shared.Response{
+				Success: false,
+				Message: fmt.Sprintf("validación fallida: %v", err),
+			}
+		}
+	}
+	return &subscription{
+		filter: dto,
+		crud:   crud,
+		events: make(chan shared.EventoDTO, subscriptionBuffer),
+	}, shared.Response{}
+}
+
+// streamEvents envía los eventos de la suscripción de sess hasta que el
+// cliente envía UNSUBSCRIBE o se desconecta, hasta que la sesión se revoca o
+// expira, o hasta que el servidor empieza a apagarse. Mientras tanto solo se
+// acepta PING, que renueva la sesión.
+func (s *Server) streamEvents(ctx context.Context, sess *session, reader *requestReader, encoder *json.Encoder) error {
+	sub := sess.subscription
+	sess.subscription = nil
+	s.events.add(sub)
+	defer s.events.remove(sub)
+	reader.conn.setStreaming(true)
+	defer reader.conn.setStreaming(false)
+	ticker := time.NewTicker(s.subscriptionCheck)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case ev := <-sub.events:
+			if response, ok := s.validSession(sess); !ok {
+				return encoder.Encode(response)
+			}
+			if !sub.visible(ctx, ev) {
+				continue
+			}
+			if err := encoder.Encode(shared.Response{Success: true, Message: ev.Tipo, Data: ev}); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if response, ok := s.validSession(sess); !ok {
+				return encoder.Encode(response)
+			}
+		case <-s.life.shuttingDown:
+			return errShuttingDown
+		case req, ok := <-reader.requests:
+			if !ok {
+				return reader.err
+			}
+			var err error
+			switch req.Operation {
+			case "UNSUBSCRIBE":
+				sess.lastActivity = time.Now()
+				return encoder.Encode(shared.Response{Success: true, Message: "Suscripción cancelada"})
+			case "PING":
+				response, ok := s.checkSession(sess)
+				if !ok {
+					return encoder.Encode(response)
+				}
+				err = encoder.Encode(s.handlePing())
+			default:
+				err = encoder.Encode(shared.Response{
+					Success: false,
+					Message: "Conexión suscrita a eventos: envíe UNSUBSCRIBE antes de otras operaciones",
+				})
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"hr-system/shared"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startSubscriptionServer arranca un servidor SQLite con el rol oyente, que
+// solo puede suscribirse, y el usuario oyente. Las pruebas publican los
+// eventos a mano en el hub, sin esperar a la base de datos.
+func startSubscriptionServer(t *testing.T, check, idle time.Duration) (*Server, *session, string) {
+	t.Helper()
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	s.subscriptionCheck = check
+	s.sessionIdleTimeout = idle
+	ctx := context.Background()
+	admin := loginSession(t, s, "admin", testAdminPassword)
+	response := s.processRequest(ctx, admin, shared.Request{Operation: "SAVE_ROL",
+		Data: shared.RolDTO{Nombre: "oyente", Alcance: shared.AlcanceTodos, Permisos: []string{"SUBSCRIBE"}}})
+	if !response.Success {
+		t.Fatalf("SAVE_ROL: %+v", response)
+	}
+	response = s.processRequest(ctx, admin, shared.Request{Operation: "CREATE_USUARIO",
+		Data: shared.CreateUsuarioDTO{Usuario: "oyente", Password: "Oyente123!", Rol: "oyente"}})
+	if !response.Success {
+		t.Fatalf("CREATE_USUARIO: %+v", response)
+	}
+	return s, admin, serveForTest(t, s)
+}
+
+// subscribe abre una conexión del usuario oyente suscrita a los cargos.
+func subscribe(t *testing.T, addr string) *rawClient {
+	t.Helper()
+	c := dialRaw(t, addr)
+	if response := c.call(t, "LOGIN", shared.LoginDTO{Usuario: "oyente", Password: "Oyente123!"}); !response.Success {
+		t.Fatalf("LOGIN: %+v", response)
+	}
+	if response := c.call(t, "SUBSCRIBE", shared.SubscribeDTO{Tipos: []string{"cargo.*"}}); !response.Success {
+		t.Fatalf("SUBSCRIBE: %+v", response)
+	}
+	return c
+}
+
+// waitSubscribed espera a que el hub registre la suscripción, que se activa
+// después de responder a SUBSCRIBE.
+func waitSubscribed(t *testing.T, s *Server) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+		s.events.mu.Lock()
+		n := len(s.events.subs)
+		s.events.mu.Unlock()
+		if n > 0 {
+			return
+		}
+	}
+	t.Fatal("la suscripción no se registró")
+}
+
+func deactivate(t *testing.T, s *Server, admin *session, usuario string) {
+	t.Helper()
+	inactivo := false
+	response := s.processRequest(context.Background(), admin, shared.Request{Operation: "UPDATE_USUARIO",
+		Data: shared.UpdateUsuarioDTO{Usuario: usuario, Activo: &inactivo}})
+	if !response.Success {
+		t.Fatalf("UPDATE_USUARIO: %+v", response)
+	}
+}
+
+func TestSubscriptionEndsWhenSessionIsRevoked(t *testing.T) {
+	cargoID := 1
+	evento := shared.EventoDTO{Tipo: shared.EventoCargoActualizado, ID: &cargoID}
+
+	t.Run("al recibir un evento", func(t *testing.T) {
+		s, admin, addr := startSubscriptionServer(t, time.Hour, time.Hour)
+		c := subscribe(t, addr)
+		waitSubscribed(t, s)
+
+		s.events.publish(evento)
+		if response := c.receive(t); !response.Success || response.Message != evento.Tipo {
+			t.Fatalf("evento: %+v", response)
+		}
+		if response := c.call(t, "PING", nil); !response.Success || response.Message != "pong" {
+			t.Fatalf("PING durante la suscripción: %+v", response)
+		}
+
+		deactivate(t, s, admin, "oyente")
+		s.events.publish(evento)
+		expectError(t, c.receive(t), shared.CodeUnauthorized, "Sesión revocada")
+		// La conexión vuelve al modo normal, sin sesión.
+		expectError(t, c.call(t, "SUBSCRIBE", nil), shared.CodeUnauthorized, "Debe iniciar sesión")
+	})
+
+	t.Run("sin eventos", func(t *testing.T) {
+		s, admin, addr := startSubscriptionServer(t, 10*time.Millisecond, time.Hour)
+		c := subscribe(t, addr)
+		waitSubscribed(t, s)
+		deactivate(t, s, admin, "oyente")
+		expectError(t, c.receive(t), shared.CodeUnauthorized, "Sesión revocada")
+	})
+
+	t.Run("por inactividad", func(t *testing.T) {
+		s, _, addr := startSubscriptionServer(t, 10*time.Millisecond, 200*time.Millisecond)
+		c := subscribe(t, addr)
+		waitSubscribed(t, s)
+		// PING renueva la sesión; sin él expira aunque siga suscrita.
+		time.Sleep(120 * time.Millisecond)
+		if response := c.call(t, "PING", nil); !response.Success {
+			t.Fatalf("PING: %+v", response)
+		}
+		time.Sleep(120 * time.Millisecond)
+		s.events.publish(evento)
+		if response := c.receive(t); response.Message != evento.Tipo {
+			t.Fatalf("evento tras PING: %+v", response)
+		}
+		response := c.receive(t)
+		if response.Code != shared.CodeUnauthorized || !strings.Contains(response.Message, "inactividad") {
+			t.Errorf("se esperaba la expiración: %+v", response)
+		}
+	})
+}
+
+func TestSubscriptionReceivesDatabaseEvents(t *testing.T) {
+	forEachIntegrationBackend(t, func(t *testing.T, s *Server, addr string) {
+		c := dialRaw(t, addr)
+		if response := c.call(t, "LOGIN", shared.LoginDTO{Usuario: "admin", Password: testAdminPassword}); !response.Success {
+			t.Fatalf("LOGIN: %+v", response)
+		}
+		if response := c.call(t, "SUBSCRIBE", nil); !response.Success {
+			t.Fatalf("SUBSCRIBE: %+v", response)
+		}
+		waitSubscribed(t, s)
+
+		admin := loginSession(t, s, "admin", testAdminPassword)
+		response := s.processRequest(context.Background(), admin, shared.Request{Operation: "INSERT",
+			Data: shared.CreateEmpleadoDTO{PrimerNombre: "Ana", Email: "ana.eventos@empresa.com",
+				FechaNac: "1990-05-15", Sueldo: 3000, CargoID: 1, DptoID: 1}})
+		if !response.Success {
+			t.Fatalf("INSERT: %+v", response)
+		}
+		// Con -race, los LOGIN pueden haber gastado el plazo de dialRaw.
+		c.SetDeadline(time.Now().Add(5 * time.Second))
+		response = c.receive(t)
+		var evento shared.EventoDTO
+		decodeData(t, response.Data, &evento)
+		if response.Message != shared.EventoEmpleadoCreado || evento.ID == nil ||
+			evento.DptoID == nil || *evento.DptoID != 1 || evento.AuditID == nil || evento.Fecha == "" {
+			t.Fatalf("evento del INSERT: %s %+v", response.Message, evento)
+		}
+
+		// Los cambios hechos directamente en la base de datos también se
+		// publican.
+		if _, err := s.db.Exec(`UPDATE cargos SET cargo_sueldo_maximo = cargo_sueldo_maximo + 1 WHERE cargo_ID = 1`); err != nil {
+			t.Fatal(err)
+		}
+		response = c.receive(t)
+		var cargo shared.EventoDTO
+		decodeData(t, response.Data, &cargo)
+		if response.Message != shared.EventoCargoActualizado || cargo.ID == nil || *cargo.ID != 1 {
+			t.Errorf("evento del cargo: %s %+v", response.Message, cargo)
+		}
+	})
+}
@@ -0,0 +1,470 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hr-system/shared"
+	"hr-system/shared/hrpb"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// grpcServer implementa hrpb.RecursosHumanosServer sobre processRequest: cada
+// mensaje se convierte al DTO de shared equivalente por sus nombres de
+// campo JSON, igual que el gateway HTTP.
+type grpcServer struct {
+	hrpb.UnimplementedRecursosHumanosServer
+	s *Server
+}
+
+func (s *Server) serveGRPC() error {
+	listener, err := net.Listen("tcp", ":"+s.grpcPort)
+	if err != nil {
+		return fmt.Errorf("error iniciando servidor gRPC: %v", err)
+	}
+	server := s.newGRPCServer()
+	registered := s.life.onShutdown(func(ctx context.Context) {
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			server.Stop()
+			<-stopped
+		}
+	})
+	if !registered {
+		listener.Close()
+		return nil
+	}
+	log.Printf("✓ Servidor gRPC iniciado en puerto %s", s.grpcPort)
+	return server.Serve(listener)
+}
+
+func (s *Server) newGRPCServer() *grpc.Server {
+	var opts []grpc.ServerOption
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+	server := grpc.NewServer(opts...)
+	hrpb.RegisterRecursosHumanosServer(server, &grpcServer{s: s})
+	return server
+}
+
+func (g *grpcServer) Login(ctx context.Context, in *hrpb.LoginRequest) (*hrpb.LoginResponse, error) {
+	data, err := messageData(in)
+	if err != nil {
+		return nil, err
+	}
+	out := &hrpb.LoginResponse{}
+	return out, responseMessage(g.s.loginToken(ctx, peerSession(ctx), data), out, "")
+}
+
+func (g *grpcServer) Logout(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	g.s.logoutToken(grpcToken(ctx))
+	return &emptypb.Empty{}, nil
+}
+
+// peerSession es una sesión sin iniciar para el cliente de ctx.
+func peerSession(ctx context.Context) *session {
+	sess := &session{}
+	if p, ok := peer.FromContext(ctx); ok {
+		sess.clientAddr = p.Addr.String()
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			sess.certUsuario = certificateUser(info.State)
+		}
+	}
+	return sess
+}
+
+// Ping renueva además la sesión del token, si se envía, para mantener
+// activa una suscripción.
+func (g *grpcServer) Ping(ctx context.Context, in *emptypb.Empty) (*hrpb.HealthStatus, error) {
+	if token := grpcToken(ctx); token != "" {
+		response := g.s.withTokenSession(token, func(sess *session) shared.Response {
+			if response, ok := g.s.checkSession(sess); !ok {
+				return response
+			}
+			return shared.Response{Success: true}
+		})
+		if !response.Success {
+			return nil, grpcError(response)
+		}
+	}
+	out := &hrpb.HealthStatus{}
+	return out, responseMessage(g.s.processRequest(ctx, peerSession(ctx), shared.Request{Operation: "PING"}), out, "")
+}
+
+func (g *grpcServer) Health(ctx context.Context, in *emptypb.Empty) (*hrpb.HealthStatus, error) {
+	response := g.s.processRequest(ctx, peerSession(ctx), shared.Request{Operation: "HEALTH"})
+	if !response.Success && response.Code == "" {
+		return nil, status.Error(codes.Unavailable, response.Message)
+	}
+	out := &hrpb.HealthStatus{}
+	return out, responseMessage(response, out, "")
+}
+
+func (g *grpcServer) Insert(ctx context.Context, in *hrpb.CreateEmpleado) (*hrpb.CreateEmpleadoResponse, error) {
+	out := &hrpb.CreateEmpleadoResponse{}
+	return out, g.unary(ctx, "INSERT", in, out, "")
+}
+
+func (g *grpcServer) Update(ctx context.Context, in *hrpb.UpdateEmpleado) (*hrpb.UpdateEmpleadoResponse, error) {
+	out := &hrpb.UpdateEmpleadoResponse{}
+	return out, g.unary(ctx, "UPDATE", in, out, "")
+}
+
+func (g *grpcServer) Patch(ctx context.Context, in *hrpb.PatchEmpleado) (*hrpb.UpdateEmpleadoResponse, error) {
+	out := &hrpb.UpdateEmpleadoResponse{}
+	return out, g.unary(ctx, "PATCH", in, out, "")
+}
+
+func (g *grpcServer) Select(ctx context.Context, in *hrpb.SelectEmpleado) (*hrpb.EmpleadoDetail, error) {
+	out := &hrpb.EmpleadoDetail{}
+	return out, g.unary(ctx, "SELECT", in, out, "")
+}
+
+func (g *grpcServer) Delete(ctx context.Context, in *hrpb.DeleteEmpleado) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, g.unary(ctx, "DELETE", in, nil, "")
+}
+
+func (g *grpcServer) Restore(ctx context.Context, in *hrpb.RestoreEmpleado) (*hrpb.UpdateEmpleadoResponse, error) {
+	out := &hrpb.UpdateEmpleadoResponse{}
+	return out, g.unary(ctx, "RESTORE", in, out, "")
+}
+
+func (g *grpcServer) Batch(ctx context.Context, in *hrpb.BatchRequest) (*hrpb.BatchResponse, error) {
+	out := &hrpb.BatchResponse{}
+	return out, g.unary(ctx, "BATCH", in, out, "")
+}
+
+func (g *grpcServer) ListAudit(in *hrpb.ListAuditRequest, stream grpc.ServerStreamingServer[hrpb.AuditEntry]) error {
+	var dto shared.ListAuditDTO
+	if err := messageDTO(in, &dto); err != nil {
+		return err
+	}
+	return streamRows(g, stream, "LIST_AUDIT", func(ctx context.Context, crud *EmpleadoCrud, send func(row any) error) error {
+		return crud.EachAudit(ctx, dto, func(entry shared.AuditEntryDTO) error {
+			return send(entry)
+		})
+	})
+}
+
+func (g *grpcServer) ListGerentes(in *emptypb.Empty, stream grpc.ServerStreamingServer[hrpb.Gerente]) error {
+	return streamRows(g, stream, "LIST_GERENTES", func(ctx context.Context, crud *EmpleadoCrud, send func(row any) error) error {
+		return crud.EachGerente(ctx, func(gerente shared.GerenteDTO) error {
+			return send(gerente)
+		})
+	})
+}
+
+func (g *grpcServer) ListCargos(ctx context.Context, in *emptypb.Empty) (*hrpb.CargoList, error) {
+	out := &hrpb.CargoList{}
+	return out, g.unary(ctx, "LIST_CARGOS", nil, out, "cargos")
+}
+
+func (g *grpcServer) ListDepartamentosConDatos(ctx context.Context, in *emptypb.Empty) (*hrpb.DepartamentoList, error) {
+	out := &hrpb.DepartamentoList{}
+	return out, g.unary(ctx, "LIST_DEPARTAMENTOS_CON_DATOS", nil, out, "departamentos")
+}
+
+func (g *grpcServer) CreateUsuario(ctx context.Context, in *hrpb.CreateUsuarioRequest) (*hrpb.Usuario, error) {
+	out := &hrpb.Usuario{}
+	return out, g.unary(ctx, "CREATE_USUARIO", in, out, "")
+}
+
+func (g *grpcServer) UpdateUsuario(ctx context.Context, in *hrpb.UpdateUsuarioRequest) (*hrpb.Usuario, error) {
+	out := &hrpb.Usuario{}
+	return out, g.unary(ctx, "UPDATE_USUARIO", in, out, "")
+}
+
+func (g *grpcServer) ListUsuarios(ctx context.Context, in *emptypb.Empty) (*hrpb.UsuarioList, error) {
+	out := &hrpb.UsuarioList{}
+	return out, g.unary(ctx, "LIST_USUARIOS", nil, out, "usuarios")
+}
+
+func (g *grpcServer) ListRoles(ctx context.Context, in *emptypb.Empty) (*hrpb.RolList, error) {
+	out := &hrpb.RolList{}
+	return out, g.unary(ctx, "LIST_ROLES", nil, out, "roles")
+}
+
+func (g *grpcServer) SaveRol(ctx context.Context, in *hrpb.Rol) (*hrpb.Rol, error) {
+	out := &hrpb.Rol{}
+	return out, g.unary(ctx, "SAVE_ROL", in, out, "")
+}
+
+func (g *grpcServer) DeleteRol(ctx context.Context, in *hrpb.DeleteRolRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, g.unary(ctx, "DELETE_ROL", in, nil, "")
+}
+
+// Subscribe envía los eventos como streamEvents en el socket: la sesión del
+// token se revisa con cada evento y cada subscriptionCheck, y el stream
+// termina si se revocó o expiró.
+func (g *grpcServer) Subscribe(in *hrpb.SubscribeRequest, stream grpc.ServerStreamingServer[hrpb.Evento]) error {
+	ctx := stream.Context()
+	token := grpcToken(ctx)
+	data, err := messageData(in)
+	if err != nil {
+		return err
+	}
+	var sub *subscription
+	var failed shared.Response
+	response := g.s.streamTokenRequest(ctx, token, "SUBSCRIBE",
+		func(_ context.Context, crud *EmpleadoCrud, _ rowMasker) error {
+			sub, failed = g.s.newSubscription(crud, data)
+			return nil
+		})
+	if !response.Success {
+		return grpcError(response)
+	}
+	if sub == nil {
+		return grpcError(failed)
+	}
+	g.s.events.add(sub)
+	defer g.s.events.remove(sub)
+	ticker := time.NewTicker(g.s.subscriptionCheck)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case ev := <-sub.events:
+			if err := g.validToken(token); err != nil {
+				return err
+			}
+			if !sub.visible(ctx, ev) {
+				continue
+			}
+			msg := &hrpb.Evento{}
+			if err := decodeMessage(ev, msg); err != nil {
+				return err
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := g.validToken(token); err != nil {
+				return err
+			}
+		case <-g.s.life.shuttingDown:
+			return status.Error(codes.Unavailable, errShuttingDown.Error())
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// validToken comprueba, sin renovarla, que la sesión del token siga activa.
+func (g *grpcServer) validToken(token string) error {
+	response := g.s.withTokenSession(token, func(sess *session) shared.Response {
+		if response, ok := g.s.validSession(sess); !ok {
+			return response
+		}
+		return shared.Response{Success: true}
+	})
+	if !response.Success {
+		return grpcError(response)
+	}
+	return nil
+}
+
+func (g *grpcServer) CreateWebhook(ctx context.Context, in *hrpb.CreateWebhookRequest) (*hrpb.Webhook, error) {
+	out := &hrpb.Webhook{}
+	return out, g.unary(ctx, "CREATE_WEBHOOK", in, out, "")
+}
+
+func (g *grpcServer) ListWebhooks(ctx context.Context, in *emptypb.Empty) (*hrpb.WebhookList, error) {
+	out := &hrpb.WebhookList{}
+	return out, g.unary(ctx, "LIST_WEBHOOKS", nil, out, "webhooks")
+}
+
+func (g *grpcServer) UpdateWebhook(ctx context.Context, in *hrpb.UpdateWebhookRequest) (*hrpb.Webhook, error) {
+	out := &hrpb.Webhook{}
+	return out, g.unary(ctx, "UPDATE_WEBHOOK", in, out, "")
+}
+
+func (g *grpcServer) DeleteWebhook(ctx context.Context, in *hrpb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, g.unary(ctx, "DELETE_WEBHOOK", in, nil, "")
+}
+
+func (g *grpcServer) ListEntregasFallidas(ctx context.Context, in *emptypb.Empty) (*hrpb.EntregaWebhookList, error) {
+	out := &hrpb.EntregaWebhookList{}
+	return out, g.unary(ctx, "LIST_ENTREGAS_FALLIDAS", nil, out, "entregas")
+}
+
+// RetryEntrega pasa el DTO directamente porque protojson codifica los int64
+// como texto.
+func (g *grpcServer) RetryEntrega(ctx context.Context, in *hrpb.RetryEntregaRequest) (*emptypb.Empty, error) {
+	response := g.s.processTokenRequest(ctx, grpcToken(ctx), shared.Request{Operation: "RETRY_ENTREGA",
+		Data: shared.RetryEntregaDTO{ID: in.EntregaId}})
+	if !response.Success {
+		return nil, grpcError(response)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// call ejecuta op con los datos de in en la sesión del token de ctx.
+func (g *grpcServer) call(ctx context.Context, op string, in proto.Message) (shared.Response, error) {
+	var data any
+	if in != nil {
+		var err error
+		if data, err = messageData(in); err != nil {
+			return shared.Response{}, err
+		}
+	}
+	response := g.s.processTokenRequest(ctx, grpcToken(ctx), shared.Request{Operation: op, Data: data})
+	if !response.Success {
+		return response, grpcError(response)
+	}
+	return response, nil
+}
+
+// unary ejecuta op y copia los datos de la respuesta en out. Con wrap, los
+// datos son una lista que va en ese campo de out.
+func (g *grpcServer) unary(ctx context.Context, op string, in, out proto.Message, wrap string) error {
+	response, err := g.call(ctx, op, in)
+	if err != nil || out == nil {
+		return err
+	}
+	return responseMessage(response, out, wrap)
+}
+
+func responseMessage(response shared.Response, out proto.Message, wrap string) error {
+	if !response.Success {
+		return grpcError(response)
+	}
+	data := response.Data
+	if wrap != "" {
+		data = map[string]any{wrap: data}
+	}
+	return decodeMessage(data, out)
+}
+
+// streamRows ejecuta op con streamTokenRequest y envía cada fila que each
+// entrega en cuanto sale de la base de datos, enmascarada según el rol, sin
+// reunir antes la lista completa.
+func streamRows[T any, PT interface {
+	*T
+	proto.Message
+}](g *grpcServer, stream grpc.ServerStreamingServer[T], op string,
+	each func(ctx context.Context, crud *EmpleadoCrud, send func(row any) error) error) error {
+	ctx := stream.Context()
+	// sendErr conserva el error de envío, que no es un error de la
+	// operación: el cliente cerró el stream o la fila no se pudo convertir.
+	var sendErr error
+	response := g.s.streamTokenRequest(ctx, grpcToken(ctx), op,
+		func(ctx context.Context, crud *EmpleadoCrud, mask rowMasker) error {
+			return each(ctx, crud, func(row any) error {
+				masked, err := mask(row)
+				if err != nil {
+					sendErr = status.Errorf(codes.Internal, "error preparando respuesta: %v", err)
+					return sendErr
+				}
+				msg := PT(new(T))
+				if sendErr = decodeMessage(masked, msg); sendErr != nil {
+					return sendErr
+				}
+				sendErr = stream.Send((*T)(msg))
+				return sendErr
+			})
+		})
+	if sendErr != nil {
+		return sendErr
+	}
+	if !response.Success {
+		return grpcError(response)
+	}
+	return nil
+}
+
+var (
+	marshalOptions   = protojson.MarshalOptions{UseProtoNames: true}
+	unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// messageData convierte msg en el valor genérico que reciben los handlers,
+// como si hubiera llegado por el socket.
+func messageData(msg proto.Message) (any, error) {
+	jsonData, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error procesando datos: %v", err)
+	}
+	var data any
+	if err := json.Unmarshal(jsonData, &data); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Error en formato de datos: %v", err)
+	}
+	return data, nil
+}
+
+// messageDTO convierte msg en el DTO de shared equivalente.
+func messageDTO(msg proto.Message, out any) error {
+	jsonData, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Error procesando datos: %v", err)
+	}
+	if err := json.Unmarshal(jsonData, out); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Error en formato de datos: %v", err)
+	}
+	return nil
+}
+
+func decodeMessage(data any, out proto.Message) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error procesando respuesta: %v", err)
+	}
+	if err := unmarshalOptions.Unmarshal(jsonData, out); err != nil {
+		return status.Errorf(codes.Internal, "error convirtiendo respuesta: %v", err)
+	}
+	return nil
+}
+
+func grpcToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, auth := range md.Get("authorization") {
+		if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+			return strings.TrimSpace(auth[7:])
+		}
+	}
+	return ""
+}
+
+// grpcError traduce el código del protocolo a un estado gRPC.
+func grpcError(response shared.Response) error {
+	code := codes.InvalidArgument
+	switch response.Code {
+	case shared.CodeUnauthorized:
+		code = codes.Unauthenticated
+	case shared.CodeForbidden:
+		code = codes.PermissionDenied
+	case shared.CodeNotFound:
+		code = codes.NotFound
+	case shared.CodeConflict:
+		code = codes.Aborted
+	case shared.CodeTimeout:
+		code = codes.DeadlineExceeded
+	case shared.CodeRateLimited:
+		code = codes.ResourceExhausted
+	case shared.CodeInternal:
+		code = codes.Internal
+	}
+	return status.Error(code, response.Message)
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"hr-system/shared"
+	"hr-system/shared/hrpb"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// startGRPCForTest atiende el servicio gRPC de s en memoria y devuelve un
+// cliente conectado.
+func startGRPCForTest(t *testing.T, s *Server) hrpb.RecursosHumanosClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := s.newGRPCServer()
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return hrpb.NewRecursosHumanosClient(conn)
+}
+
+// grpcLogin inicia sesión y devuelve un contexto con el token.
+func grpcLogin(t *testing.T, client hrpb.RecursosHumanosClient, usuario, password string) context.Context {
+	t.Helper()
+	login, err := client.Login(context.Background(), &hrpb.LoginRequest{Usuario: usuario, Password: password})
+	if err != nil {
+		t.Fatalf("Login %s: %v", usuario, err)
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+login.Token)
+}
+
+func expectGRPCCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Errorf("se esperaba %v: %v", code, err)
+	}
+}
+
+// recvAll lee un stream hasta el final.
+func recvAll[T any](t *testing.T, stream grpc.ServerStreamingClient[T]) ([]*T, error) {
+	t.Helper()
+	var items []*T
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return items, nil
+		}
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+}
+
+func TestGRPCService(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	client := startGRPCForTest(t, s)
+
+	_, err := client.Login(context.Background(), &hrpb.LoginRequest{Usuario: "admin", Password: "incorrecta"})
+	expectGRPCCode(t, err, codes.Unauthenticated)
+	_, err = client.ListCargos(context.Background(), &emptypb.Empty{})
+	expectGRPCCode(t, err, codes.Unauthenticated)
+
+	admin := grpcLogin(t, client, "admin", testAdminPassword)
+	cargos, err := client.ListCargos(admin, &emptypb.Empty{})
+	if err != nil || len(cargos.Cargos) == 0 {
+		t.Fatalf("ListCargos: %v %v", cargos, err)
+	}
+	departamentos, err := client.ListDepartamentosConDatos(admin, &emptypb.Empty{})
+	if err != nil || len(departamentos.Departamentos) == 0 {
+		t.Fatalf("ListDepartamentosConDatos: %v %v", departamentos, err)
+	}
+	created, err := client.Insert(admin, &hrpb.CreateEmpleado{
+		EmplPrimerNombre: "Ana",
+		EmplEmail:        "ana.grpc@empresa.com",
+		EmplFechaNac:     "1990-05-15",
+		EmplSueldo:       2500,
+		EmplCargoId:      cargos.Cargos[0].CargoId,
+		EmplDptoId:       departamentos.Departamentos[0].DptoId,
+	})
+	if err != nil {
+		t.Fatalf("Insert: %v", err)
+	}
+	_, err = client.Select(admin, &hrpb.SelectEmpleado{EmplId: created.EmplId + 1000})
+	expectGRPCCode(t, err, codes.NotFound)
+	_, err = client.Update(admin, &hrpb.UpdateEmpleado{EmplId: created.EmplId, EmplVersion: created.EmplVersion + 5,
+		EmplPrimerNombre: "Ana", EmplEmail: "ana.grpc@empresa.com", EmplFechaNac: "1990-05-15", EmplSueldo: 2600,
+		EmplCargoId: cargos.Cargos[0].CargoId, EmplDptoId: departamentos.Departamentos[0].DptoId})
+	expectGRPCCode(t, err, codes.Aborted)
+
+	stream, err := client.ListGerentes(admin, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gerentes, err := recvAll(t, stream)
+	want, _ := s.crud.ListGerentes(context.Background())
+	if err != nil || len(gerentes) != len(want) {
+		t.Fatalf("ListGerentes: %d gerentes, se esperaban %d: %v", len(gerentes), len(want), err)
+	}
+	if last := gerentes[len(gerentes)-1]; last.EmplId != created.EmplId || last.NombreCompleto == "" {
+		t.Errorf("último gerente: %v", last)
+	}
+
+	_, err = client.CreateUsuario(admin, &hrpb.CreateUsuarioRequest{Usuario: "analista", Password: "Analista123!", Rol: "hr_analyst"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	analyst := grpcLogin(t, client, "analista", "Analista123!")
+	_, err = client.ListUsuarios(analyst, &emptypb.Empty{})
+	expectGRPCCode(t, err, codes.PermissionDenied)
+
+	// Cada fila del stream se enmascara según el rol.
+	emplID := created.EmplId
+	audit, err := client.ListAudit(analyst, &hrpb.ListAuditRequest{EmplId: &emplID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := recvAll(t, audit)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("ListAudit: %v %v", entries, err)
+	}
+	despues := entries[0].Despues.AsMap()
+	if despues["empl_sueldo"] != nil || despues["empl_primer_nombre"] != "Ana" {
+		t.Errorf("fila de auditoría sin enmascarar: %v", despues)
+	}
+
+	s.limiter = newRateLimiter(0.001, 1)
+	if _, err := client.ListCargos(admin, &emptypb.Empty{}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ListCargos(admin, &emptypb.Empty{})
+	expectGRPCCode(t, err, codes.ResourceExhausted)
+	stream, err = client.ListGerentes(admin, &emptypb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = recvAll(t, stream)
+	expectGRPCCode(t, err, codes.ResourceExhausted)
+}
+
+func TestGRPCErrorCodes(t *testing.T) {
+	casos := map[string]codes.Code{
+		"":                      codes.InvalidArgument,
+		shared.CodeUnauthorized: codes.Unauthenticated,
+		shared.CodeForbidden:    codes.PermissionDenied,
+		shared.CodeNotFound:     codes.NotFound,
+		shared.CodeConflict:     codes.Aborted,
+		shared.CodeTimeout:      codes.DeadlineExceeded,
+		shared.CodeRateLimited:  codes.ResourceExhausted,
+	}
+	for code, want := range casos {
+		err := grpcError(shared.Response{Code: code, Message: "mensaje"})
+		if status.Code(err) != want || status.Convert(err).Message() != "mensaje" {
+			t.Errorf("grpcError(%q) = %v, se esperaba %v", code, err, want)
+		}
+	}
+}
+
+func TestGRPCPingAndHealth(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	client := startGRPCForTest(t, s)
+
+	ping, err := client.Ping(context.Background(), &emptypb.Empty{})
+	if err != nil || !ping.Vivo {
+		t.Fatalf("Ping: %v %v", ping, err)
+	}
+	health, err := client.Health(context.Background(), &emptypb.Empty{})
+	if err != nil || !health.Listo || !health.BaseDatosOk || health.BaseDatos != "sqlite" {
+		t.Fatalf("Health: %v %v", health, err)
+	}
+	_, err = client.Ping(metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer desconocido"),
+		&emptypb.Empty{})
+	expectGRPCCode(t, err, codes.Unauthenticated)
+
+	s.db.Close()
+	_, err = client.Health(context.Background(), &emptypb.Empty{})
+	expectGRPCCode(t, err, codes.Unavailable)
+}
+
+func TestGRPCWebhooks(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	client := startGRPCForTest(t, s)
+	admin := grpcLogin(t, client, "admin", testAdminPassword)
+
+	wh, err := client.CreateWebhook(admin, &hrpb.CreateWebhookRequest{Url: "https://hooks.empresa.com/hr",
+		Tipos: []string{"empleado.*"}, Secreto: "secreto-de-prueba-123"})
+	if err != nil || wh.WhId == 0 || !wh.Activo {
+		t.Fatalf("CreateWebhook: %v %v", wh, err)
+	}
+	_, err = client.CreateWebhook(admin, &hrpb.CreateWebhookRequest{Url: "ftp://empresa.com", Secreto: "secreto-de-prueba-123"})
+	expectGRPCCode(t, err, codes.InvalidArgument)
+	inactivo := false
+	updated, err := client.UpdateWebhook(admin, &hrpb.UpdateWebhookRequest{WhId: wh.WhId, Activo: &inactivo})
+	if err != nil || updated.Activo {
+		t.Fatalf("UpdateWebhook: %v %v", updated, err)
+	}
+	list, err := client.ListWebhooks(admin, &emptypb.Empty{})
+	if err != nil || len(list.Webhooks) != 1 || list.Webhooks[0].Tipos[0] != "empleado.*" {
+		t.Fatalf("ListWebhooks: %v %v", list, err)
+	}
+
+	if _, err := s.db.Exec(`INSERT INTO webhook_outbox (out_wh_ID, out_tipo, out_payload, out_estado, out_intentos)
+		VALUES ($1, 'empleado.created', '{"id":7}', 'fallido', $2)`, wh.WhId, webhookMaxIntentos); err != nil {
+		t.Fatal(err)
+	}
+	fallidas, err := client.ListEntregasFallidas(admin, &emptypb.Empty{})
+	if err != nil || len(fallidas.Entregas) != 1 || fallidas.Entregas[0].Payload.AsMap()["id"] != 7.0 {
+		t.Fatalf("ListEntregasFallidas: %v %v", fallidas, err)
+	}
+	if _, err := client.RetryEntrega(admin, &hrpb.RetryEntregaRequest{EntregaId: fallidas.Entregas[0].EntregaId}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.RetryEntrega(admin, &hrpb.RetryEntregaRequest{EntregaId: fallidas.Entregas[0].EntregaId})
+	expectGRPCCode(t, err, codes.NotFound)
+
+	_, err = client.CreateUsuario(admin, &hrpb.CreateUsuarioRequest{Usuario: "analista", Password: "Analista123!", Rol: "hr_analyst"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ListWebhooks(grpcLogin(t, client, "analista", "Analista123!"), &emptypb.Empty{})
+	expectGRPCCode(t, err, codes.PermissionDenied)
+
+	if _, err := client.DeleteWebhook(admin, &hrpb.DeleteWebhookRequest{WhId: wh.WhId}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.DeleteWebhook(admin, &hrpb.DeleteWebhookRequest{WhId: wh.WhId})
+	expectGRPCCode(t, err, codes.NotFound)
+}
+
+func TestGRPCSubscribe(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	s.subscriptionCheck = 10 * time.Millisecond
+	client := startGRPCForTest(t, s)
+	admin := grpcLogin(t, client, "admin", testAdminPassword)
+	_, err := client.CreateUsuario(admin, &hrpb.CreateUsuarioRequest{Usuario: "analista", Password: "Analista123!", Rol: "hr_analyst"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	analyst := grpcLogin(t, client, "analista", "Analista123!")
+
+	stream, err := client.Subscribe(analyst, &hrpb.SubscribeRequest{Tipos: []string{"usuario.*"}})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	expectGRPCCode(t, err, codes.InvalidArgument)
+
+	ctx, cancel := context.WithCancel(analyst)
+	defer cancel()
+	stream, err = client.Subscribe(ctx, &hrpb.SubscribeRequest{Tipos: []string{"cargo.*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitSubscribed(t, s)
+	cargoID := 1
+	s.events.publish(shared.EventoDTO{Tipo: shared.EventoDepartamentoActualizado, ID: &cargoID})
+	s.events.publish(shared.EventoDTO{Tipo: shared.EventoCargoActualizado, ID: &cargoID})
+	evento, err := stream.Recv()
+	if err != nil || evento.Tipo != shared.EventoCargoActualizado || evento.GetId() != 1 {
+		t.Fatalf("evento: %v %v", evento, err)
+	}
+	if _, err := client.Ping(analyst, &emptypb.Empty{}); err != nil {
+		t.Fatalf("Ping con token: %v", err)
+	}
+
+	// Desactivar al usuario termina el stream sin esperar otro evento.
+	inactivo := false
+	if _, err := client.UpdateUsuario(admin, &hrpb.UpdateUsuarioRequest{Usuario: "analista", Activo: &inactivo}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = stream.Recv()
+	expectGRPCCode(t, err, codes.Unauthenticated)
+}
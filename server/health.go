@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"hr-system/shared"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// liveness informa los datos del proceso, sin consultar la base de datos:
+// basta con que el servidor responda para considerarlo vivo.
+func (s *Server) liveness() shared.HealthDTO {
+	uptime := time.Since(s.startedAt)
+	return shared.HealthDTO{
+		Vivo:               true,
+		Uptime:             uptime.Round(time.Second).String(),
+		UptimeSegundos:     int64(uptime.Seconds()),
+		ConexionesAbiertas: s.life.openConns(),
+		Apagando:           s.life.isShuttingDown(),
+	}
+}
+
+// readiness completa liveness con la base de datos y el esquema. El servidor
+// está listo si no se está apagando, la base de datos responde y no hay
+// migraciones pendientes.
+func (s *Server) readiness(ctx context.Context) shared.HealthDTO {
+	health := s.liveness()
+	if health.Apagando {
+		health.Problemas = append(health.Problemas, "el servidor se está apagando")
+	}
+	if s.db == nil {
+		health.Problemas = append(health.Problemas, "sin base de datos")
+		return health
+	}
+	health.BaseDatos = string(s.dialect)
+	health.ConexionesBD = s.db.Stats().OpenConnections
+	if err := s.checkDB(ctx); err != nil {
+		// HEALTH no requiere sesión: el detalle queda en el log del servidor.
+		log.Printf("Health: base de datos no disponible: %v", err)
+		health.Problemas = append(health.Problemas, "base de datos no disponible")
+		return health
+	}
+	health.BaseDatosOK = true
+	migrator, err := NewMigrator(s.db, s.dialect)
+	if err == nil {
+		health.VersionEsquema, health.MigracionesPendientes, err = migrator.SchemaVersion(ctx)
+	}
+	switch {
+	case err != nil:
+		log.Printf("Health: error consultando el esquema: %v", err)
+		health.Problemas = append(health.Problemas, "error consultando el esquema")
+	case health.MigracionesPendientes > 0:
+		health.Problemas = append(health.Problemas, fmt.Sprintf("%d migraciones pendientes", health.MigracionesPendientes))
+	}
+	health.Listo = len(health.Problemas) == 0
+	return health
+}
+
+// handlePing responde PING, la comprobación de vida. No requiere sesión.
+func (s *Server) handlePing() shared.Response {
+	return shared.Response{
+		Success: true,
+		Message: "pong",
+		Data:    s.liveness(),
+	}
+}
+
+// handleHealth responde HEALTH, la comprobación de disponibilidad. No
+// requiere sesión; falla si el servidor no está listo, con el detalle en
+// Data.
+func (s *Server) handleHealth(ctx context.Context) shared.Response {
+	health := s.readiness(ctx)
+	if !health.Listo {
+		return shared.Response{
+			Success: false,
+			Message: "Servidor no disponible: " + strings.Join(health.Problemas, "; "),
+			Data:    health,
+		}
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Servidor disponible",
+		Data:    health,
+	}
+}
+
+// handleHTTPLive es la comprobación de vida del gateway: responde 200
+// mientras el proceso atienda peticiones.
+func (s *Server) handleHTTPLive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeHTTPError(w, http.StatusMethodNotAllowed, errMetodoNoPermitido.Error())
+		return
+	}
+	writeHTTPResponse(w, s.handlePing(), http.StatusOK)
+}
+
+// handleHTTPReady es la comprobación de disponibilidad del gateway: responde
+// 503 mientras el servidor no esté listo, para que el balanceador no le
+// envíe tráfico.
+func (s *Server) handleHTTPReady(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeHTTPError(w, http.StatusMethodNotAllowed, errMetodoNoPermitido.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeouts.forOperation("HEALTH"))
+	defer cancel()
+	response := s.handleHealth(ctx)
+	status := http.StatusOK
+	if !response.Success {
+		status = http.StatusServiceUnavailable
+	}
+	writeHTTPJSON(w, status, response)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"hr-system/shared"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// getHealth consulta una ruta de salud del gateway y decodifica el informe.
+func getHealth(t *testing.T, s *Server, path string) (int, shared.HealthDTO) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	s.httpHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	var response struct {
+		Data shared.HealthDTO `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("GET %s: respuesta inválida: %v", path, err)
+	}
+	return w.Code, response.Data
+}
+
+func TestPingAndHealthWithoutSession(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	c := dialRaw(t, serveForTest(t, s))
+
+	response := c.call(t, "PING", nil)
+	if !response.Success || response.Message != "pong" {
+		t.Errorf("PING: %+v", response)
+	}
+	response = c.call(t, "HEALTH", nil)
+	if !response.Success {
+		t.Fatalf("HEALTH: %+v", response)
+	}
+	var health shared.HealthDTO
+	decodeData(t, response.Data, &health)
+	if !health.Vivo || !health.Listo || !health.BaseDatosOK || health.BaseDatos != "sqlite" {
+		t.Errorf("informe: %+v", health)
+	}
+	if health.ConexionesAbiertas != 1 || health.MigracionesPendientes != 0 || health.VersionEsquema == 0 {
+		t.Errorf("informe: %+v", health)
+	}
+	expectError(t, c.call(t, "LIST_CARGOS", nil), shared.CodeUnauthorized, "Debe iniciar sesión")
+}
+
+func TestHTTPReadinessFailsWithPendingMigrations(t *testing.T) {
+	db := openSQLiteTestDB(t)
+	s := newDBTestServer(t, db, dbConfig{dialect: dialectSQLite})
+	if code, health := getHealth(t, s, "/health/ready"); code != http.StatusOK || !health.Listo {
+		t.Fatalf("listo: %d %+v", code, health)
+	}
+
+	migrator, err := NewMigrator(db, dialectSQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Down(1); err != nil {
+		t.Fatal(err)
+	}
+	code, health := getHealth(t, s, "/health/ready")
+	if code != http.StatusServiceUnavailable || health.Listo || health.MigracionesPendientes != 1 {
+		t.Errorf("con una migración pendiente: %d %+v", code, health)
+	}
+	if len(health.Problemas) != 1 || !strings.Contains(health.Problemas[0], "1 migraciones pendientes") {
+		t.Errorf("problemas: %v", health.Problemas)
+	}
+	if code, health := getHealth(t, s, "/health/live"); code != http.StatusOK || !health.Vivo {
+		t.Errorf("vivo: %d %+v", code, health)
+	}
+}
+
+func TestHTTPReadinessFailsWithoutDatabase(t *testing.T) {
+	db := openSQLiteTestDB(t)
+	s := newDBTestServer(t, db, dbConfig{dialect: dialectSQLite})
+	db.Close()
+
+	code, health := getHealth(t, s, "/health/ready")
+	if code != http.StatusServiceUnavailable || health.Listo || health.BaseDatosOK {
+		t.Errorf("sin base de datos: %d %+v", code, health)
+	}
+	if len(health.Problemas) != 1 || health.Problemas[0] != "base de datos no disponible" {
+		t.Errorf("el problema expone el error de la base de datos: %v", health.Problemas)
+	}
+	if code, _ := getHealth(t, s, "/health/live"); code != http.StatusOK {
+		t.Errorf("vivo sin base de datos: %d", code)
+	}
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxHTTPBody limita el cuerpo de las peticiones REST.
+const maxHTTPBody = 1 << 20
+
+var errRutaNoEncontrada = errors.New("recurso no encontrado")
+
+var errMetodoNoPermitido = errors.New("método no permitido")
+
+// httpRoute traduce una petición REST a la operación equivalente del
+// protocolo de socket.
+type httpRoute func(r *http.Request, segments []string, body any) (shared.Request, error)
+
+// httpHandler expone las operaciones del protocolo como recursos REST. Cada
+// petición se resuelve con processRequest, así que autenticación, permisos,
+// alcance y enmascarado son los mismos que en el socket.
+func (s *Server) httpHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/openapi.json", handleOpenAPI)
+	mux.HandleFunc("/docs", handleDocs)
+	mux.HandleFunc("/login", s.handleHTTPLogin)
+	mux.HandleFunc("/logout", s.handleHTTPLogout)
+	mux.HandleFunc("/health/live", s.handleHTTPLive)
+	mux.HandleFunc("/health/ready", s.handleHTTPReady)
+	mux.Handle("/empleados", s.httpOperation(routeEmpleados))
+	mux.Handle("/empleados/", s.httpOperation(routeEmpleados))
+	mux.Handle("/cargos", s.httpOperation(routeList("LIST_CARGOS")))
+	mux.Handle("/departamentos", s.httpOperation(routeList("LIST_DEPARTAMENTOS_CON_DATOS")))
+	mux.Handle("/gerentes", s.httpOperation(routeList("LIST_GERENTES")))
+	mux.Handle("/auditoria", s.httpOperation(routeAuditoria))
+	mux.Handle("/batch", s.httpOperation(routeBatch))
+	mux.Handle("/usuarios", s.httpOperation(routeUsuarios))
+	mux.Handle("/usuarios/", s.httpOperation(routeUsuarios))
+	mux.Handle("/roles", s.httpOperation(routeRoles))
+	mux.Handle("/roles/", s.httpOperation(routeRoles))
+	return mux
+}
+
+func (s *Server) serveHTTP() error {
+	server := &http.Server{
+		Addr:              ":" + s.httpPort,
+		Handler:           s.httpHandler(),
+		TLSConfig:         s.tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	// Al apagar, el gateway deja de aceptar peticiones y espera a las que
+	// están en curso; si vence el plazo, cierra sus conexiones.
+	registered := s.life.onShutdown(func(ctx context.Context) {
+		if err := server.Shutdown(ctx); err != nil {
+			server.Close()
+		}
+	})
+	if !registered {
+		return nil
+	}
+	log.Printf("✓ Gateway HTTP iniciado en puerto %s", s.httpPort)
+	var err error
+	if s.tlsConfig != nil {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) handleHTTPLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeHTTPError(w, http.StatusMethodNotAllowed, errMetodoNoPermitido.Error())
+		return
+	}
+	body, err := readHTTPBody(r)
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sess := &session{clientAddr: r.RemoteAddr}
+	if r.TLS != nil {
+		sess.certUsuario = certificateUser(*r.TLS)
+	}
+	writeHTTPResponse(w, s.loginToken(r.Context(), sess, body), http.StatusOK)
+}
+
+func (s *Server) handleHTTPLogout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeHTTPError(w, http.StatusMethodNotAllowed, errMetodoNoPermitido.Error())
+		return
+	}
+	s.logoutToken(bearerToken(r))
+	writeHTTPResponse(w, shared.Response{Success: true, Message: "Sesión cerrada"}, http.StatusOK)
+}
+
+// httpOperation autentica la petición con su token, la traduce con route y
+// la ejecuta con processRequest.
+func (s *Server) httpOperation(route httpRoute) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := bearerToken(r)
+		if s.lookupTokenSession(token) == nil {
+			writeHTTPResponse(w, shared.Response{
+				Success: false,
+				Code:    shared.CodeUnauthorized,
+				Message: "Debe iniciar sesión con POST /login y enviar el token en Authorization: Bearer",
+			}, http.StatusOK)
+			return
+		}
+		body, err := readHTTPBody(r)
+		if err != nil {
+			writeHTTPError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		req, err := route(r, segments, body)
+		if err != nil {
+			switch {
+			case errors.Is(err, errRutaNoEncontrada):
+				writeHTTPError(w, http.StatusNotFound, err.Error())
+			case errors.Is(err, errMetodoNoPermitido):
+				writeHTTPError(w, http.StatusMethodNotAllowed, err.Error())
+			default:
+				writeHTTPError(w, http.StatusBadRequest, err.Error())
+			}
+			return
+		}
+		response := s.processTokenRequest(r.Context(), token, req)
+		status := http.StatusOK
+		if r.Method == http.MethodPost && req.Operation != "BATCH" && req.Operation != "RESTORE" {
+			status = http.StatusCreated
+		}
+		writeHTTPResponse(w, response, status)
+	})
+}
+
+func routeEmpleados(r *http.Request, segments []string, body any) (shared.Request, error) {
+	switch len(segments) {
+	case 1:
+		if r.Method != http.MethodPost {
+			return shared.Request{}, errMetodoNoPermitido
+		}
+		return shared.Request{Operation: "INSERT", Data: body}, nil
+	case 2, 3:
+	default:
+		return shared.Request{}, errRutaNoEncontrada
+	}
+	id, err := strconv.Atoi(segments[1])
+	if err != nil || id <= 0 {
+		return shared.Request{}, fmt.Errorf("ID del empleado inválido: %s", segments[1])
+	}
+	if len(segments) == 3 {
+		if segments[2] != "restaurar" {
+			return shared.Request{}, errRutaNoEncontrada
+		}
+		if r.Method != http.MethodPost {
+			return shared.Request{}, errMetodoNoPermitido
+		}
+		data, err := withField(body, "empl_id", id)
+		return shared.Request{Operation: "RESTORE", Data: data}, err
+	}
+	switch r.Method {
+	case http.MethodGet:
+		return shared.Request{Operation: "SELECT", Data: map[string]any{"empl_id": float64(id)}}, nil
+	case http.MethodPut:
+		data, err := withField(body, "empl_id", id)
+		return shared.Request{Operation: "UPDATE", Data: data}, err
+	case http.MethodPatch:
+		data, err := withField(body, "empl_id", id)
+		return shared.Request{Operation: "PATCH", Data: data}, err
+	case http.MethodDelete:
+		version, err := requestVersion(r, body)
+		if err != nil {
+			return shared.Request{}, err
+		}
+		return shared.Request{Operation: "DELETE", Data: map[string]any{
+			"empl_id":      float64(id),
+			"empl_version": float64(version),
+		}}, nil
+	default:
+		return shared.Request{}, errMetodoNoPermitido
+	}
+}
+
+func routeList(operation string) httpRoute {
+	return func(r *http.Request, segments []string, body any) (shared.Request, error) {
+		if r.Method != http.MethodGet {
+			return shared.Request{}, errMetodoNoPermitido
+		}
+		return shared.Request{Operation: operation}, nil
+	}
+}
+
+func routeAuditoria(r *http.Request, segments []string, body any) (shared.Request, error) {
+	if r.Method != http.MethodGet {
+		return shared.Request{}, errMetodoNoPermitido
+	}
+	query := r.URL.Query()
+	filter := shared.ListAuditDTO{
+		Operador: query.Get("operador"),
+		Desde:    query.Get("desde"),
+		Hasta:    query.Get("hasta"),
+	}
+	if v := query.Get("empl_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return shared.Request{}, fmt.Errorf("empl_id inválido: %s", v)
+		}
+		filter.EmplID = &id
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return shared.Request{}, fmt.Errorf("limit inválido: %s", v)
+		}
+		filter.Limit = limit
+	}
+	return shared.Request{Operation: "LIST_AUDIT", Data: filter}, nil
+}
+
+func routeBatch(r *http.Request, segments []string, body any) (shared.Request, error) {
+	if r.Method != http.MethodPost {
+		return shared.Request{}, errMetodoNoPermitido
+	}
+	return shared.Request{Operation: "BATCH", Data: body}, nil
+}
+
+func routeUsuarios(r *http.Request, segments []string, body any) (shared.Request, error) {
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		return shared.Request{Operation: "LIST_USUARIOS"}, nil
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		return shared.Request{Operation: "CREATE_USUARIO", Data: body}, nil
+	case len(segments) == 2 && r.Method == http.MethodPatch:
+		data, err := withField(body, "usuario", segments[1])
+		return shared.Request{Operation: "UPDATE_USUARIO", Data: data}, err
+	case len(segments) <= 2:
+		return shared.Request{}, errMetodoNoPermitido
+	default:
+		return shared.Request{}, errRutaNoEncontrada
+	}
+}
+
+func routeRoles(r *http.Request, segments []string, body any) (shared.Request, error) {
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		return shared.Request{Operation: "LIST_ROLES"}, nil
+	case len(segments) == 2 && r.Method == http.MethodPut:
+		data, err := withField(body, "rol_nombre", segments[1])
+		return shared.Request{Operation: "SAVE_ROL", Data: data}, err
+	case len(segments) == 2 && r.Method == http.MethodDelete:
+		return shared.Request{Operation: "DELETE_ROL", Data: shared.DeleteRolDTO{Nombre: segments[1]}}, nil
+	case len(segments) <= 2:
+		return shared.Request{}, errMetodoNoPermitido
+	default:
+		return shared.Request{}, errRutaNoEncontrada
+	}
+}
+
+// withField fija en el cuerpo JSON el identificador tomado de la ruta, que
+// prevalece sobre el enviado en el cuerpo.
+func withField(body any, key string, value any) (map[string]any, error) {
+	if body == nil {
+		body = map[string]any{}
+	}
+	data, ok := body.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("el cuerpo debe ser un objeto JSON")
+	}
+	if id, ok := value.(int); ok {
+		value = float64(id)
+	}
+	data[key] = value
+	return data, nil
+}
+
+// requestVersion lee la versión esperada del encabezado If-Match, del
+// parámetro empl_version o del cuerpo.
+func requestVersion(r *http.Request, body any) (int, error) {
+	v := strings.Trim(r.Header.Get("If-Match"), `"`)
+	if v == "" {
+		v = r.URL.Query().Get("empl_version")
+	}
+	if v == "" {
+		if data, ok := body.(map[string]any); ok {
+			if version, ok := data["empl_version"].(float64); ok {
+				return int(version), nil
+			}
+		}
+		return 0, fmt.Errorf("Versión del empleado es requerida (If-Match o empl_version)")
+	}
+	version, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("versión inválida: %s", v)
+	}
+	return version, nil
+}
+
+func readHTTPBody(r *http.Request) (any, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxHTTPBody))
+	var body any
+	if err := decoder.Decode(&body); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error en formato de datos: %v", err)
+	}
+	return body, nil
+}
+
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+// httpStatus traduce el código del protocolo a un estado HTTP; success usa
+// el estado indicado por la ruta.
+func httpStatus(response shared.Response, success int) int {
+	if response.Success {
+		return success
+	}
+	switch response.Code {
+	case shared.CodeUnauthorized:
+		return http.StatusUnauthorized
+	case shared.CodeForbidden:
+		return http.StatusForbidden
+	case shared.CodeNotFound:
+		return http.StatusNotFound
+	case shared.CodeConflict:
+		return http.StatusConflict
+	case shared.CodeRateLimited:
+		return http.StatusTooManyRequests
+	case shared.CodeTimeout:
+		return http.StatusGatewayTimeout
+	case shared.CodeInternal:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func writeHTTPResponse(w http.ResponseWriter, response shared.Response, success int) {
+	writeHTTPJSON(w, httpStatus(response, success), response)
+}
+
+func writeHTTPError(w http.ResponseWriter, status int, message string) {
+	writeHTTPJSON(w, status, shared.Response{Success: false, Message: message})
+}
+
+func writeHTTPJSON(w http.ResponseWriter, status int, response shared.Response) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("Error enviando response HTTP: %v", err)
+	}
+}
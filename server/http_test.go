@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hr-system/shared"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// httpTestClient envía peticiones al gateway HTTP de un servidor de prueba.
+type httpTestClient struct {
+	handler http.Handler
+	token   string
+}
+
+// do envía body como JSON y devuelve el estado y la respuesta, con Data sin
+// decodificar.
+func (c *httpTestClient) do(t *testing.T, method, path string, body any, header ...string) (int, shared.Response) {
+	t.Helper()
+	var reader bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reader).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := httptest.NewRequest(method, path, &reader)
+	if c.token != "" {
+		r.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	c.handler.ServeHTTP(w, r)
+	var response shared.Response
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("%s %s: respuesta inválida %q: %v", method, path, w.Body.String(), err)
+	}
+	return w.Code, response
+}
+
+// expect verifica el estado de la respuesta y decodifica Data en out.
+func (c *httpTestClient) expect(t *testing.T, status int, method, path string, body any, out any, header ...string) shared.Response {
+	t.Helper()
+	code, response := c.do(t, method, path, body, header...)
+	if code != status {
+		t.Fatalf("%s %s = %d, se esperaba %d: %+v", method, path, code, status, response)
+	}
+	if out != nil {
+		decodeData(t, response.Data, out)
+	}
+	return response
+}
+
+func TestHTTPGatewayRoutes(t *testing.T) {
+	db := openSQLiteTestDB(t)
+	s := newDBTestServer(t, db, dbConfig{dialect: dialectSQLite})
+	c := &httpTestClient{handler: s.httpHandler()}
+
+	c.expect(t, http.StatusUnauthorized, "POST", "/login", shared.LoginDTO{Usuario: "admin", Password: "incorrecta"}, nil)
+	c.expect(t, http.StatusMethodNotAllowed, "GET", "/login", nil, nil)
+	c.expect(t, http.StatusUnauthorized, "GET", "/cargos", nil, nil)
+	var login shared.LoginResponseDTO
+	c.expect(t, http.StatusOK, "POST", "/login", shared.LoginDTO{Usuario: "admin", Password: testAdminPassword}, &login)
+	c.token = login.Token
+
+	// Catálogos.
+	var cargos []shared.CargoDTO
+	c.expect(t, http.StatusOK, "GET", "/cargos", nil, &cargos)
+	c.expect(t, http.StatusMethodNotAllowed, "POST", "/cargos", nil, nil)
+	var departamentos []map[string]any
+	c.expect(t, http.StatusOK, "GET", "/departamentos", nil, &departamentos)
+	c.expect(t, http.StatusOK, "GET", "/gerentes", nil, nil)
+	if len(cargos) == 0 || len(departamentos) == 0 {
+		t.Fatalf("catálogos vacíos: %v %v", cargos, departamentos)
+	}
+
+	// Empleados: POST crea con 201; el resto responde 200.
+	nuevo := shared.CreateEmpleadoDTO{
+		PrimerNombre: "Ana",
+		Email:        "ana.http@empresa.com",
+		FechaNac:     "1990-05-15",
+		Sueldo:       2500,
+		CargoID:      cargos[0].ID,
+		DptoID:       int(departamentos[0]["dpto_id"].(float64)),
+	}
+	var created shared.CreateEmpleadoResponseDTO
+	c.expect(t, http.StatusCreated, "POST", "/empleados", nuevo, &created)
+	path := fmt.Sprintf("/empleados/%d", created.ID)
+	c.expect(t, http.StatusBadRequest, "POST", "/empleados", shared.CreateEmpleadoDTO{}, nil)
+	c.expect(t, http.StatusBadRequest, "POST", "/empleados", nuevo, nil)
+	c.expect(t, http.StatusMethodNotAllowed, "PUT", "/empleados", nuevo, nil)
+	c.expect(t, http.StatusOK, "GET", path, nil, nil)
+	c.expect(t, http.StatusNotFound, "GET", "/empleados/99999", nil, nil)
+	c.expect(t, http.StatusBadRequest, "GET", "/empleados/abc", nil, nil)
+	c.expect(t, http.StatusNotFound, "GET", path+"/otra", nil, nil)
+	c.expect(t, http.StatusNotFound, "GET", path+"/restaurar/1", nil, nil)
+	c.expect(t, http.StatusMethodNotAllowed, "GET", path+"/restaurar", nil, nil)
+
+	var updated shared.UpdateEmpleadoResponseDTO
+	nombre := "Beatriz"
+	c.expect(t, http.StatusOK, "PATCH", path, shared.PatchEmpleadoDTO{PrimerNombre: &nombre, Version: created.Version}, &updated)
+	stale := shared.UpdateEmpleadoDTO{PrimerNombre: "Ana", Email: nuevo.Email, FechaNac: nuevo.FechaNac,
+		Sueldo: nuevo.Sueldo, CargoID: nuevo.CargoID, DptoID: nuevo.DptoID, Version: created.Version}
+	c.expect(t, http.StatusConflict, "PUT", path, stale, nil)
+	stale.Version = updated.Version
+	c.expect(t, http.StatusOK, "PUT", path, stale, &updated)
+	c.expect(t, http.StatusBadRequest, "DELETE", path, nil, nil)
+	c.expect(t, http.StatusOK, "DELETE", path, nil, nil, "If-Match", fmt.Sprintf(`"%d"`, updated.Version))
+	c.expect(t, http.StatusOK, "POST", path+"/restaurar", map[string]any{"empl_version": updated.Version + 1}, nil)
+
+	// Auditoría y lotes.
+	var entries []shared.AuditEntryDTO
+	c.expect(t, http.StatusOK, "GET", fmt.Sprintf("/auditoria?empl_id=%d", created.ID), nil, &entries)
+	if len(entries) != 5 {
+		t.Errorf("auditoría: %d registros", len(entries))
+	}
+	c.expect(t, http.StatusBadRequest, "GET", "/auditoria?empl_id=abc", nil, nil)
+	c.expect(t, http.StatusMethodNotAllowed, "POST", "/auditoria", nil, nil)
+	batch := shared.BatchRequestDTO{Steps: []shared.BatchStepDTO{
+		{Operation: "SELECT", Data: map[string]any{"empl_id": created.ID}},
+	}}
+	c.expect(t, http.StatusOK, "POST", "/batch", batch, nil)
+	c.expect(t, http.StatusMethodNotAllowed, "GET", "/batch", nil, nil)
+
+	// Usuarios y roles.
+	c.expect(t, http.StatusCreated, "POST", "/usuarios",
+		shared.CreateUsuarioDTO{Usuario: "analista", Password: "Analista123!", Rol: "hr_analyst"}, nil)
+	c.expect(t, http.StatusOK, "GET", "/usuarios", nil, nil)
+	c.expect(t, http.StatusOK, "PATCH", "/usuarios/analista", map[string]any{"rol": "hr_manager"}, nil)
+	c.expect(t, http.StatusNotFound, "PATCH", "/usuarios/nadie", map[string]any{"rol": "hr_manager"}, nil)
+	c.expect(t, http.StatusMethodNotAllowed, "DELETE", "/usuarios/analista", nil, nil)
+	c.expect(t, http.StatusNotFound, "GET", "/usuarios/a/b", nil, nil)
+	rol := shared.RolDTO{Descripcion: "Solo catálogos", Alcance: shared.AlcanceTodos, Permisos: []string{"LIST_CARGOS"}}
+	c.expect(t, http.StatusOK, "PUT", "/roles/auditor", rol, nil)
+	c.expect(t, http.StatusOK, "GET", "/roles", nil, nil)
+	c.expect(t, http.StatusOK, "DELETE", "/roles/auditor", nil, nil)
+	c.expect(t, http.StatusMethodNotAllowed, "POST", "/roles", rol, nil)
+	c.expect(t, http.StatusNotFound, "GET", "/roles/a/b", nil, nil)
+
+	// Un fallo de la base de datos es un error del servidor, no de la
+	// petición.
+	db.Close()
+	response := c.expect(t, http.StatusInternalServerError, "GET", "/cargos", nil, nil)
+	if response.Code != shared.CodeInternal {
+		t.Errorf("código = %q", response.Code)
+	}
+	c.expect(t, http.StatusOK, "POST", "/logout", nil, nil)
+	c.expect(t, http.StatusUnauthorized, "GET", "/cargos", nil, nil)
+}
+
+func TestHTTPStatus(t *testing.T) {
+	casos := map[string]int{
+		"":                      http.StatusBadRequest,
+		shared.CodeUnauthorized: http.StatusUnauthorized,
+		shared.CodeForbidden:    http.StatusForbidden,
+		shared.CodeNotFound:     http.StatusNotFound,
+		shared.CodeConflict:     http.StatusConflict,
+		shared.CodeRateLimited:  http.StatusTooManyRequests,
+		shared.CodeTimeout:      http.StatusGatewayTimeout,
+		shared.CodeInternal:     http.StatusInternalServerError,
+	}
+	for code, want := range casos {
+		if got := httpStatus(shared.Response{Code: code}, http.StatusOK); got != want {
+			t.Errorf("httpStatus(%q) = %d, se esperaba %d", code, got, want)
+		}
+	}
+	if got := httpStatus(shared.Response{Success: true}, http.StatusCreated); got != http.StatusCreated {
+		t.Errorf("éxito = %d", got)
+	}
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hr-system/hrclient"
+	"hr-system/shared"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testAdminPassword = "Secreta123!"
+
+// integrationBackends son las bases de datos sobre las que corren las pruebas
+// de integración; el servidor completo debe comportarse igual con cualquiera
+// de ellas.
+var integrationBackends = []struct {
+	name string
+	open func(t *testing.T) (*sql.DB, dbConfig)
+}{
+	{"sqlite", func(t *testing.T) (*sql.DB, dbConfig) {
+		return openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite}
+	}},
+	{"postgres", func(t *testing.T) (*sql.DB, dbConfig) {
+		return requirePostgres(t).newDatabase(t)
+	}},
+}
+
+// forEachIntegrationBackend arranca el servidor de socket completo sobre una
+// base de datos nueva de cada backend, en un puerto libre, y ejecuta test con
+// el servidor y su dirección.
+func forEachIntegrationBackend(t *testing.T, test func(t *testing.T, s *Server, addr string)) {
+	for _, backend := range integrationBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			db, cfg := backend.open(t)
+			s := newDBTestServer(t, db, cfg)
+			test(t, s, serveForTest(t, s))
+		})
+	}
+}
+
+// newDBTestServer prepara un servidor sobre db con el usuario admin.
+func newDBTestServer(t *testing.T, db *sql.DB, cfg dbConfig) *Server {
+	t.Helper()
+	s := NewServer("0")
+	if err := s.useDB(db, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.usuarios.EnsureAdmin(context.Background(), "admin", testAdminPassword); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// serveForTest atiende el socket de s en un puerto libre hasta que termina la
+// prueba, y entonces lo apaga como si recibiera una señal. Devuelve la
+// dirección.
+func serveForTest(t *testing.T, s *Server) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- s.serve(listener) }()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.Shutdown(ctx); err != nil {
+			t.Errorf("error apagando el servidor: %v", err)
+		}
+		if err := <-served; err != nil {
+			t.Error(err)
+		}
+	})
+	return listener.Addr().String()
+}
+
+// dialAdmin abre un cliente con sesión de administrador y hasta n conexiones,
+// que se cierra al terminar la prueba.
+func dialAdmin(t *testing.T, addr string, n int) *hrclient.Client {
+	t.Helper()
+	c, err := hrclient.New(hrclient.Config{Address: addr, Usuario: "admin", Password: testAdminPassword, MaxConns: n})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	if _, err := c.Login(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// concurrently ejecuta la misma operación n veces a la vez, con un cliente de
+// n conexiones, y devuelve el error de cada una.
+func concurrently(t *testing.T, addr string, n int, op func(ctx context.Context, c *hrclient.Client, i int) error) []error {
+	t.Helper()
+	c := dialAdmin(t, addr, n)
+	errs := make([]error, n)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = op(context.Background(), c, i)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	return errs
+}
+
+// countSuccesses cuenta las operaciones exitosas y falla la prueba si alguna
+// falló por un error que no es del servidor.
+func countSuccesses(t *testing.T, errs []error) int {
+	t.Helper()
+	n := 0
+	for _, err := range errs {
+		var apiErr *hrclient.Error
+		if err == nil {
+			n++
+		} else if !errors.As(err, &apiErr) {
+			t.Fatal(err)
+		}
+	}
+	return n
+}
+
+func TestIntegrationSeedDataAndDelete(t *testing.T) {
+	forEachIntegrationBackend(t, func(t *testing.T, s *Server, addr string) {
+		c := dialAdmin(t, addr, 1)
+		ctx := context.Background()
+
+		cargos, err := c.ListCargos(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cargos) != 5 {
+			t.Errorf("cargos de los datos iniciales: %+v", cargos)
+		}
+		jose, err := c.GetEmpleado(ctx, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if jose.PrimerNombre != "José" || jose.GerenteNombre == nil || *jose.GerenteNombre != "Carlos Alberto" {
+			t.Fatalf("empleado inicial inesperado: %+v", jose)
+		}
+
+		if err := c.DeleteEmpleado(ctx, jose.ID, jose.Version); err != nil {
+			t.Fatal(err)
+		}
+		var historico []struct{ cargo, dpto int }
+		rows, err := s.db.Query(`SELECT emphist_cargo_ID, emphist_dpto_ID FROM historico`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for rows.Next() {
+			var h struct{ cargo, dpto int }
+			if err := rows.Scan(&h.cargo, &h.dpto); err != nil {
+				t.Fatal(err)
+			}
+			historico = append(historico, h)
+		}
+		rows.Close()
+		if len(historico) != 1 || historico[0].cargo != jose.CargoID || historico[0].dpto != jose.DptoID {
+			t.Errorf("p_delete_empleado no registró el retiro: %+v", historico)
+		}
+
+		err = c.DeleteEmpleado(ctx, jose.ID, jose.Version+1)
+		if !errors.Is(err, hrclient.ErrNotFound) || !strings.Contains(err.Error(), "ya está eliminado") {
+			t.Errorf("segundo DELETE: %v", err)
+		}
+
+		// SQLite no tiene procedimientos almacenados; el store hace el retiro
+		// en Go.
+		if s.dialect == dialectPostgres {
+			var success bool
+			var message string
+			err = s.db.QueryRow(`SELECT success, message FROM p_delete_empleado(999)`).Scan(&success, &message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if success || !strings.Contains(message, "no encontrado") {
+				t.Errorf("p_delete_empleado(999): %v %q", success, message)
+			}
+		}
+
+		restored, err := c.RestoreEmpleado(ctx, jose.ID, jose.Version+1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if restored.Version != jose.Version+2 {
+			t.Errorf("versión restaurada = %d", restored.Version)
+		}
+		entries, err := c.ListAudit(ctx, shared.ListAuditDTO{EmplID: &jose.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 2 || entries[0].Operacion != AuditRestore || entries[1].Operacion != AuditDelete {
+			t.Errorf("auditoría: %+v", entries)
+		}
+		if _, err := s.db.Exec(`DELETE FROM auditoria`); err == nil {
+			t.Error("se eliminó la auditoría")
+		}
+	})
+}
+
+func TestIntegrationConcurrentPatchesConflict(t *testing.T) {
+	forEachIntegrationBackend(t, func(t *testing.T, _ *Server, addr string) {
+		const n = 8
+		errs := concurrently(t, addr, n, func(ctx context.Context, c *hrclient.Client, i int) error {
+			sueldo := float64(3000000 + i)
+			_, err := c.PatchEmpleado(ctx, shared.PatchEmpleadoDTO{ID: 4, Sueldo: &sueldo, Version: 1})
+			return err
+		})
+		if got := countSuccesses(t, errs); got != 1 {
+			t.Fatalf("%d PATCH concurrentes con la misma versión tuvieron éxito", got)
+		}
+		for _, err := range errs {
+			if err != nil && !errors.Is(err, hrclient.ErrConflict) {
+				t.Errorf("se esperaba CONFLICT: %v", err)
+			}
+		}
+
+		c := dialAdmin(t, addr, 1)
+		ctx := context.Background()
+		detail, err := c.GetEmpleado(ctx, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if detail.Version != 2 {
+			t.Errorf("versión final = %d", detail.Version)
+		}
+		emplID := 4
+		entries, err := c.ListAudit(ctx, shared.ListAuditDTO{EmplID: &emplID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 {
+			t.Errorf("se auditaron %d cambios", len(entries))
+		}
+	})
+}
+
+func TestIntegrationConcurrentDeletes(t *testing.T) {
+	forEachIntegrationBackend(t, func(t *testing.T, s *Server, addr string) {
+		errs := concurrently(t, addr, 8, func(ctx context.Context, c *hrclient.Client, _ int) error {
+			return c.DeleteEmpleado(ctx, 5, 1)
+		})
+		if got := countSuccesses(t, errs); got != 1 {
+			t.Fatalf("%d DELETE concurrentes tuvieron éxito", got)
+		}
+		var retiros int
+		if err := s.db.QueryRow(`SELECT COUNT(*) FROM historico`).Scan(&retiros); err != nil {
+			t.Fatal(err)
+		}
+		if retiros != 1 {
+			t.Errorf("el histórico tiene %d retiros", retiros)
+		}
+	})
+}
+
+func TestIntegrationConcurrentInsertsSameEmail(t *testing.T) {
+	forEachIntegrationBackend(t, func(t *testing.T, _ *Server, addr string) {
+		errs := concurrently(t, addr, 8, func(ctx context.Context, c *hrclient.Client, i int) error {
+			_, err := c.CreateEmpleado(ctx, shared.CreateEmpleadoDTO{
+				PrimerNombre: fmt.Sprintf("Ana%d", i), Email: "ana@empresa.com", FechaNac: "1990-05-15",
+				Sueldo: 2000000, CargoID: 1, DptoID: 1,
+			})
+			return err
+		})
+		if got := countSuccesses(t, errs); got != 1 {
+			t.Fatalf("%d INSERT concurrentes con el mismo email tuvieron éxito", got)
+		}
+		for _, err := range errs {
+			if err != nil && !strings.Contains(err.Error(), "email ya existe") {
+				t.Errorf("error inesperado: %v", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"log"
+	"math"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// connLimits protege al servidor de clientes que abren demasiadas
+// conexiones, las dejan abiertas sin usarlas o envían peticiones demasiado
+// grandes o demasiado seguidas. Un cero desactiva el límite correspondiente.
+type connLimits struct {
+	// maxConns es el máximo de conexiones de socket simultáneas.
+	maxConns int
+	// idleTimeout es la espera máxima entre una petición y la siguiente;
+	// no se aplica mientras la conexión está suscrita a eventos.
+	idleTimeout time.Duration
+	// readTimeout es el plazo para terminar de recibir una petición que ya
+	// empezó a llegar, y writeTimeout el de cada respuesta.
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+	// maxRequestBytes es el tamaño máximo de una petición.
+	maxRequestBytes int64
+	// rate son las peticiones por segundo que admite cada usuario, o cada
+	// dirección antes de LOGIN, con ráfagas de hasta burst.
+	rate  float64
+	burst int
+}
+
+func defaultConnLimits() connLimits {
+	return connLimits{
+		maxConns:        500,
+		idleTimeout:     5 * time.Minute,
+		readTimeout:     30 * time.Second,
+		writeTimeout:    30 * time.Second,
+		maxRequestBytes: 1 << 20,
+		rate:            50,
+		burst:           100,
+	}
+}
+
+// connLimitsFromEnv lee MAX_CONNECTIONS, CONN_IDLE_TIMEOUT,
+// CONN_READ_TIMEOUT, CONN_WRITE_TIMEOUT, MAX_REQUEST_BYTES, RATE_LIMIT y
+// RATE_BURST sobre los valores por defecto.
+func connLimitsFromEnv() (connLimits, error) {
+	limits := defaultConnLimits()
+	for name, target := range map[string]*time.Duration{
+		"CONN_IDLE_TIMEOUT":  &limits.idleTimeout,
+		"CONN_READ_TIMEOUT":  &limits.readTimeout,
+		"CONN_WRITE_TIMEOUT": &limits.writeTimeout,
+	} {
+		if v := os.Getenv(name); v != "" {
+			timeout, err := time.ParseDuration(v)
+			if err != nil || timeout < 0 {
+				return connLimits{}, fmt.Errorf("%s inválido: %s", name, v)
+			}
+			*target = timeout
+		}
+	}
+	for name, target := range map[string]*int{
+		"MAX_CONNECTIONS": &limits.maxConns,
+		"RATE_BURST":      &limits.burst,
+	} {
+		if v := os.Getenv(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				return connLimits{}, fmt.Errorf("%s inválido: %s", name, v)
+			}
+			*target = n
+		}
+	}
+	if v := os.Getenv("MAX_REQUEST_BYTES"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			return connLimits{}, fmt.Errorf("MAX_REQUEST_BYTES inválido: %s", v)
+		}
+		limits.maxRequestBytes = n
+	}
+	if v := os.Getenv("RATE_LIMIT"); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil || rate < 0 || math.IsInf(rate, 0) {
+			return connLimits{}, fmt.Errorf("RATE_LIMIT inválido: %s", v)
+		}
+		limits.rate = rate
+	}
+	if limits.rate > 0 && limits.burst == 0 {
+		return connLimits{}, fmt.Errorf("RATE_BURST debe ser positivo si RATE_LIMIT está activo")
+	}
+	return limits, nil
+}
+
+var (
+	errRequestTooLarge = errors.New("petición demasiado grande")
+	errTooManyConns    = errors.New("demasiadas conexiones")
+)
+
+// limitedConn aplica a una conexión de cliente los plazos y el tamaño máximo
+// de connLimits. El lector de peticiones llama a waitRequest antes de cada
+// petición: desde ahí corre idleTimeout, y en cuanto llega el primer byte,
+// readTimeout.
+type limitedConn struct {
+	net.Conn
+	limits connLimits
+
+	mu        sync.Mutex
+	idle      bool
+	streaming bool
+	read      int64
+}
+
+func newLimitedConn(conn net.Conn, limits connLimits) *limitedConn {
+	return &limitedConn{Conn: conn, limits: limits}
+}
+
+// waitRequest empieza la espera de una nueva petición. Los bytes que el
+// decodificador ya tenía leídos de antemano no cuentan para su tamaño.
+func (c *limitedConn) waitRequest() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.idle = true
+	c.read = 0
+	c.setIdleDeadline()
+}
+
+// setStreaming quita el plazo de inactividad mientras la conexión recibe
+// eventos, porque el cliente solo escucha.
+func (c *limitedConn) setStreaming(streaming bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.streaming = streaming
+	if c.idle {
+		c.setIdleDeadline()
+	}
+}
+
+func (c *limitedConn) setIdleDeadline() {
+	var deadline time.Time
+	if c.limits.idleTimeout > 0 && !c.streaming {
+		deadline = time.Now().Add(c.limits.idleTimeout)
+	}
+	c.Conn.SetReadDeadline(deadline)
+}
+
+// Read no entrega más de maxRequestBytes por petición: el decodificador
+// usaría una petición completa aunque la lectura fallara.
+func (c *limitedConn) Read(p []byte) (int, error) {
+	if max := c.limits.maxRequestBytes; max > 0 {
+		c.mu.Lock()
+		remaining := max - c.read
+		c.mu.Unlock()
+		if remaining <= 0 {
+			return 0, errRequestTooLarge
+		}
+		if int64(len(p)) > remaining {
+			p = p[:remaining]
+		}
+	}
+	n, err := c.Conn.Read(p)
+	if n == 0 {
+		return n, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.idle {
+		c.idle = false
+		var deadline time.Time
+		if c.limits.readTimeout > 0 {
+			deadline = time.Now().Add(c.limits.readTimeout)
+		}
+		c.Conn.SetReadDeadline(deadline)
+	}
+	c.read += int64(n)
+	return n, err
+}
+
+func (c *limitedConn) Write(p []byte) (int, error) {
+	if c.limits.writeTimeout > 0 {
+		c.Conn.SetWriteDeadline(time.Now().Add(c.limits.writeTimeout))
+	}
+	return c.Conn.Write(p)
+}
+
+// rejectConn responde RATE_LIMITED a una conexión que excede maxConns y la
+// cierra, sin esperar más de writeTimeout.
+func rejectConn(conn net.Conn, limits connLimits) {
+	defer conn.Close()
+	log.Printf("Conexión de %s rechazada: máximo de %d conexiones alcanzado", conn.RemoteAddr(), limits.maxConns)
+	writeTimeout := limits.writeTimeout
+	if writeTimeout == 0 {
+		writeTimeout = defaultConnLimits().writeTimeout
+	}
+	conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	json.NewEncoder(conn).Encode(shared.Response{
+		Success: false,
+		Code:    shared.CodeRateLimited,
+		Message: fmt.Sprintf("El servidor alcanzó el máximo de %d conexiones simultáneas; intente más tarde", limits.maxConns),
+	})
+}
+
+// rateLimiter es un token bucket por clave: cada clave acumula rate fichas
+// por segundo hasta burst, y cada petición gasta una.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// maxRateBuckets acota la memoria del limitador: al superarse, se descartan
+// los buckets llenos, que equivalen a uno nuevo.
+const maxRateBuckets = 10000
+
+// newRateLimiter devuelve nil, que no limita, si rate es cero.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &rateLimiter{rate: rate, burst: float64(burst), buckets: make(map[string]*tokenBucket)}
+}
+
+func (l *rateLimiter) allow(key string) bool {
+	if l == nil {
+		return true
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxRateBuckets {
+			l.sweep(now)
+		}
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// rateKey identifica al cliente para el limitador: el usuario si inició
+// sesión y, si no, la dirección IP, para frenar también los LOGIN.
+func rateKey(sess *session) string {
+	if sess.user != nil {
+		return "usuario:" + sess.user.Usuario
+	}
+	host, _, err := net.SplitHostPort(sess.clientAddr)
+	if err != nil {
+		host = sess.clientAddr
+	}
+	return "ip:" + host
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"hr-system/shared"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rawClient habla el protocolo de socket sin hrclient, para ver las
+// respuestas que el servidor envía por su cuenta.
+type rawClient struct {
+	net.Conn
+	encoder *json.Encoder
+	decoder *json.Decoder
+}
+
+func dialRaw(t *testing.T, addr string) *rawClient {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	return &rawClient{Conn: conn, encoder: json.NewEncoder(conn), decoder: json.NewDecoder(conn)}
+}
+
+func (c *rawClient) call(t *testing.T, op string, data any) shared.Response {
+	t.Helper()
+	if err := c.encoder.Encode(shared.Request{Operation: op, Data: data}); err != nil {
+		t.Fatal(err)
+	}
+	return c.receive(t)
+}
+
+func (c *rawClient) receive(t *testing.T) shared.Response {
+	t.Helper()
+	var response shared.Response
+	if err := c.decoder.Decode(&response); err != nil {
+		t.Fatalf("no se recibió respuesta: %v", err)
+	}
+	return response
+}
+
+// expectClosed verifica que el servidor cerró la conexión.
+func (c *rawClient) expectClosed(t *testing.T) {
+	t.Helper()
+	var response shared.Response
+	if err := c.decoder.Decode(&response); err == nil {
+		t.Errorf("la conexión sigue abierta: %+v", response)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(10, 2)
+	if !limiter.allow("a") || !limiter.allow("a") {
+		t.Fatal("se rechazó la ráfaga inicial")
+	}
+	if limiter.allow("a") {
+		t.Error("se admitió una petición sin fichas")
+	}
+	if !limiter.allow("b") {
+		t.Error("el límite de una clave afectó a otra")
+	}
+	time.Sleep(150 * time.Millisecond)
+	if !limiter.allow("a") {
+		t.Error("las fichas no se repusieron")
+	}
+
+	var unlimited *rateLimiter
+	for i := 0; i < 1000; i++ {
+		if !unlimited.allow("a") {
+			t.Fatal("un limitador nil rechazó una petición")
+		}
+	}
+}
+
+func TestProcessRequestRateLimitsPerUser(t *testing.T) {
+	f := newTestFixture(t, NewMemoryStore())
+	f.server.limiter = newRateLimiter(0.001, 3)
+	analyst := newTestSession("hr_analyst", shared.AlcanceTodos, nil)
+	for i := 0; i < 3; i++ {
+		f.mustCall(t, analyst, "LIST_CARGOS", nil, nil)
+	}
+	expectError(t, f.call(t, analyst, "LIST_CARGOS", nil), shared.CodeRateLimited, "Demasiadas peticiones")
+
+	// Otro usuario, y los clientes sin sesión, tienen su propio límite.
+	f.mustCall(t, newTestSession("hr_manager", shared.AlcanceTodos, nil), "LIST_CARGOS", nil, nil)
+	expectError(t, f.call(t, &session{clientAddr: "10.0.0.1:5000"}, "LIST_CARGOS", nil),
+		shared.CodeUnauthorized, "Debe iniciar sesión")
+}
+
+func TestConnectionLimits(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	s.limits.maxConns = 1
+	s.limits.maxRequestBytes = 512
+	addr := serveForTest(t, s)
+
+	first := dialRaw(t, addr)
+	first.call(t, "LOGOUT", nil)
+
+	second := dialRaw(t, addr)
+	response := second.receive(t)
+	if response.Code != shared.CodeRateLimited || !strings.Contains(response.Message, "máximo de 1 conexiones") {
+		t.Errorf("conexión extra: %+v", response)
+	}
+	second.expectClosed(t)
+
+	response = first.call(t, "LOGIN", shared.LoginDTO{Usuario: "admin", Password: strings.Repeat("x", 1024)})
+	if response.Code != shared.CodeRateLimited || !strings.Contains(response.Message, "tamaño máximo de 512 bytes") {
+		t.Errorf("petición grande: %+v", response)
+	}
+	first.expectClosed(t)
+}
+
+func TestIdleConnectionsAreClosed(t *testing.T) {
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	s.limits.idleTimeout = 100 * time.Millisecond
+	addr := serveForTest(t, s)
+
+	c := dialRaw(t, addr)
+	c.call(t, "LOGOUT", nil)
+	start := time.Now()
+	c.expectClosed(t)
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("la conexión inactiva se cerró tras %v", elapsed)
+	}
+}
@@ -1,70 +1,259 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hr-system/shared"
 	"log"
 	"net"
+	"net/http"
 	"os"
-
-	_ "github.com/lib/pq"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 )
 
+// session guarda el estado de una conexión de cliente. user es nil hasta
+// que el cliente completa LOGIN. certUsuario es el usuario que identifica el
+// certificado de cliente, si la conexión usa mTLS. streaming indica que la
+// conexión admite SUBSCRIBE; subscription queda pendiente hasta que
+// handleClient empieza a enviar los eventos. userGen es la generación del
+// usuario al iniciar sesión; ver revokeUserSessions.
+type session struct {
+	clientAddr   string
+	certUsuario  string
+	user         *usuarioAutenticado
+	userGen      uint64
+	lastActivity time.Time
+	streaming    bool
+	subscription *subscription
+}
+
+// actor identifica al usuario de la sesión ante EmpleadoCrud.
+func (sess *session) actor(ocultas map[string]bool) Actor {
+	return Actor{
+		Operador: sess.user.Usuario,
+		Cliente:  sess.clientAddr,
+		Alcance:  sess.user.Alcance,
+		EmplID:   sess.user.EmplID,
+		Ocultas:  ocultas,
+	}
+}
+
 type Server struct {
-	db   *sql.DB
-	crud *EmpleadoCrud
-	port string
+	db                 *sql.DB
+	dialect            dialect
+	connStr            string
+	events             *eventHub
+	crud               *EmpleadoCrud
+	usuarios           *UsuarioCrud
+	authz              *Authorizer
+	webhooks           *WebhookCrud
+	port               string
+	sessionIdleTimeout time.Duration
+	subscriptionCheck  time.Duration
+	tlsConfig          *tls.Config
+	httpPort           string
+	grpcPort           string
+	autoMigrate        bool
+	tokenMu            sync.Mutex
+	tokenSessions      map[string]*tokenSession
+	userGenMu          sync.Mutex
+	userGens           map[string]uint64
+	timeouts           requestTimeouts
+	shutdownGrace      time.Duration
+	life               *lifecycle
+	limits             connLimits
+	limiter            *rateLimiter
+	dbPool             poolConfig
+	dbCheckInterval    time.Duration
+	startedAt          time.Time
 }
 
 func NewServer(port string) *Server {
-	return &Server{
-		port: port,
+	s := &Server{
+		port:               port,
+		sessionIdleTimeout: defaultSessionIdleTimeout,
+		subscriptionCheck:  defaultSubscriptionCheck,
+		timeouts:           defaultRequestTimeouts(),
+		shutdownGrace:      defaultShutdownGrace,
+		life:               newLifecycle(),
+		limits:             defaultConnLimits(),
+		dbCheckInterval:    defaultDBCheckInterval,
+		startedAt:          time.Now(),
 	}
+	s.limiter = newRateLimiter(s.limits.rate, s.limits.burst)
+	return s
 }
 
-func (s *Server) connectDB() error {
-	dbHost := os.Getenv("DB_HOST")
-	dbPort := os.Getenv("DB_PORT")
-	dbUser := os.Getenv("DB_USER")
-	dbPassword := os.Getenv("DB_PASSWORD")
-	dbName := os.Getenv("DB_NAME")
-	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		dbHost, dbPort, dbUser, dbPassword, dbName)
-	db, err := sql.Open("postgres", connStr)
+func (s *Server) connectDB(ctx context.Context) error {
+	db, cfg, err := openDB(ctx)
 	if err != nil {
-		return fmt.Errorf("error conectando a la base de datos: %v", err)
+		return err
 	}
-	err = db.Ping()
-	if err != nil {
-		return fmt.Errorf("error haciendo ping a la base de datos: %v", err)
+	if err := s.useDB(db, cfg); err != nil {
+		db.Close()
+		return err
 	}
-	s.db = db
-	s.crud = NewEmpleadoCrud(db)
-	log.Println("✓ Conexión a PostgreSQL establecida exitosamente")
+	log.Printf("✓ Conexión a %s establecida exitosamente", cfg.dialect)
 	return nil
 }
 
-func (s *Server) Start() error {
-	if err := s.connectDB(); err != nil {
+// useDB verifica el esquema de db y prepara sobre ella los accesos a datos
+// del servidor.
+func (s *Server) useDB(db *sql.DB, cfg dbConfig) error {
+	if err := s.checkSchema(db, cfg.dialect); err != nil {
 		return err
 	}
-	defer s.db.Close()
+	s.db = db
+	s.dialect = cfg.dialect
+	s.connStr = cfg.dsn
+	s.dbPool = cfg.pool
+	if cfg.dialect == dialectSQLite {
+		s.crud = NewEmpleadoCrud(NewSQLiteStore(db))
+	} else {
+		s.crud = NewEmpleadoCrud(NewPostgresStore(db))
+	}
+	s.webhooks = NewWebhookCrud(db, cfg.dialect)
+	s.events = newEventHub()
+	s.usuarios = NewUsuarioCrud(db)
+	s.authz = NewAuthorizer(db)
+	return s.authz.Load(context.Background())
+}
+
+// checkSchema se niega a continuar con un esquema desactualizado, salvo que
+// autoMigrate permita aplicar las migraciones pendientes.
+func (s *Server) checkSchema(db *sql.DB, d dialect) error {
+	migrator, err := NewMigrator(db, d)
+	if err != nil {
+		return err
+	}
+	if s.autoMigrate {
+		count, err := migrator.Up()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			log.Printf("✓ %d migraciones aplicadas", count)
+		}
+	}
+	return migrator.Check()
+}
+
+// Start conecta la base de datos y atiende clientes hasta que ctx se
+// cancela; entonces apaga el servidor con el plazo de gracia shutdownGrace y
+// cierra la base de datos.
+func (s *Server) Start(ctx context.Context) error {
+	if err := s.connectDB(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		s.db.Close()
+		log.Println("✓ Conexión a la base de datos cerrada")
+	}()
+	adminUser := os.Getenv("HR_ADMIN_USER")
+	if adminUser == "" {
+		adminUser = "admin"
+	}
+	created, err := s.usuarios.EnsureAdmin(ctx, adminUser, os.Getenv("HR_ADMIN_PASSWORD"))
+	if err != nil {
+		return fmt.Errorf("error preparando usuario administrador: %v", err)
+	}
+	if created {
+		log.Printf("✓ Usuario administrador inicial '%s' creado", adminUser)
+	}
 	listener, err := net.Listen("tcp", ":"+s.port)
 	if err != nil {
 		return fmt.Errorf("error iniciando servidor: %v", err)
 	}
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+		log.Println("✓ TLS habilitado")
+	}
 	defer listener.Close()
-	log.Printf("✓ Servidor iniciado en puerto %s", s.port)
+	stop := context.AfterFunc(ctx, func() {
+		log.Printf("Apagando servidor: las operaciones en curso tienen %v para terminar", s.shutdownGrace)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownGrace)
+		defer cancel()
+		if err := s.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Apagado forzado: %v", err)
+		}
+	})
+	defer stop()
+	return s.serve(listener)
+}
+
+// serve atiende clientes en listener, junto con los servicios auxiliares
+// configurados, hasta que listener se cierra. Si lo cerró Shutdown, espera a
+// que el apagado termine.
+func (s *Server) serve(listener net.Listener) error {
+	if !s.life.addListener(listener) {
+		listener.Close()
+		<-s.life.done
+		return nil
+	}
+	if s.httpPort != "" {
+		go func() {
+			if err := s.serveHTTP(); err != nil {
+				log.Printf("Error en gateway HTTP: %v", err)
+			}
+		}()
+	}
+	if s.db != nil {
+		s.life.goWorker(s.monitorDB)
+	}
+	switch s.dialect {
+	case dialectPostgres:
+		s.life.goWorker(func(ctx context.Context) {
+			s.listenEvents(ctx, s.connStr)
+		})
+	case dialectSQLite:
+		s.life.goWorker(s.pollEvents)
+	}
+	if s.webhooks != nil {
+		s.life.goWorker(func(ctx context.Context) {
+			s.webhooks.runWebhooks(ctx, &http.Client{Timeout: webhookTimeout})
+		})
+	}
+	if s.grpcPort != "" {
+		go func() {
+			if err := s.serveGRPC(); err != nil {
+				log.Printf("Error en servidor gRPC: %v", err)
+			}
+		}()
+	}
+	log.Printf("✓ Servidor iniciado en %s", listener.Addr())
 	log.Println("✓ Esperando conexiones de clientes...")
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				if s.life.isShuttingDown() {
+					<-s.life.done
+					log.Println("✓ Servidor detenido")
+				}
+				return nil
+			}
 			log.Printf("Error aceptando conexión: %v", err)
 			continue
 		}
-		go s.handleClient(conn)
+		if err := s.life.addConn(conn, s.limits.maxConns); err != nil {
+			if errors.Is(err, errTooManyConns) {
+				go rejectConn(conn, s.limits)
+			} else {
+				conn.Close()
+			}
+			continue
+		}
+		go func() {
+			defer s.life.removeConn(conn)
+			s.handleClient(conn)
+		}()
 	}
 }
 
@@ -72,50 +261,260 @@ func (s *Server) handleClient(conn net.Conn) {
 	defer conn.Close()
 	clientAddr := conn.RemoteAddr().String()
 	log.Printf("✓ Cliente conectado desde: %s", clientAddr)
-	decoder := json.NewDecoder(conn)
-	encoder := json.NewEncoder(conn)
+	sess := &session{
+		clientAddr: clientAddr,
+		streaming:  true,
+	}
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		if s.limits.readTimeout > 0 {
+			tlsConn.SetDeadline(time.Now().Add(s.limits.readTimeout))
+		}
+		err := tlsConn.Handshake()
+		tlsConn.SetDeadline(time.Time{})
+		if err != nil {
+			log.Printf("Error en handshake TLS con %s: %v", clientAddr, err)
+			return
+		}
+		sess.certUsuario = certificateUser(tlsConn.ConnectionState())
+		if sess.certUsuario != "" {
+			log.Printf("✓ Certificado de cliente para '%s' desde %s", sess.certUsuario, clientAddr)
+		}
+	}
+	// ctx se cancela cuando el cliente se desconecta, y con él la operación
+	// en curso; también si vence el plazo de gracia del apagado.
+	ctx, cancel := context.WithCancel(s.life.requestCtx)
+	defer cancel()
+	limited := newLimitedConn(conn, s.limits)
+	reader := readRequests(ctx, cancel, limited)
+	encoder := json.NewEncoder(limited)
 	for {
-		var req shared.Request
-		if err := decoder.Decode(&req); err != nil {
+		req, err := s.nextRequest(reader)
+		if errors.Is(err, errShuttingDown) {
+			sendShutdownNotice(encoder, clientAddr)
+			break
+		}
+		if errors.Is(err, errRequestTooLarge) {
+			log.Printf("Petición de %s excede %d bytes", clientAddr, s.limits.maxRequestBytes)
+			encoder.Encode(shared.Response{
+				Success: false,
+				Code:    shared.CodeRateLimited,
+				Message: fmt.Sprintf("La petición excede el tamaño máximo de %d bytes", s.limits.maxRequestBytes),
+			})
+			break
+		}
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			log.Printf("Conexión de %s cerrada por inactividad o lectura lenta", clientAddr)
+			break
+		}
+		if err != nil {
 			log.Printf("Error decodificando request: %v", err)
 			break
 		}
 		log.Printf("Operación recibida: %s", req.Operation)
-		response := s.processRequest(req)
+		response := s.processRequest(ctx, sess, req)
 		if err := encoder.Encode(response); err != nil {
 			log.Printf("Error enviando response: %v", err)
 			break
 		}
+		if sess.subscription != nil {
+			err := s.streamEvents(ctx, sess, reader, encoder)
+			if errors.Is(err, errShuttingDown) {
+				sendShutdownNotice(encoder, clientAddr)
+				break
+			}
+			if err != nil {
+				log.Printf("Suscripción de %s terminada: %v", clientAddr, err)
+				break
+			}
+		}
 	}
 	log.Printf("✓ Cliente %s desconectado", clientAddr)
 }
 
-func (s *Server) processRequest(req shared.Request) shared.Response {
+// requestReader lee las peticiones de una conexión en su propia goroutine,
+// para notar que el cliente se desconectó mientras se atiende una petición.
+type requestReader struct {
+	conn     *limitedConn
+	requests chan shared.Request
+	// err es el error de lectura que cerró requests.
+	err error
+}
+
+// readRequests decodifica peticiones hasta que la lectura falla o ctx se
+// cancela. Al fallar la lectura cancela ctx y cierra requests.
+func readRequests(ctx context.Context, cancel context.CancelFunc, conn *limitedConn) *requestReader {
+	r := &requestReader{conn: conn, requests: make(chan shared.Request)}
+	decoder := json.NewDecoder(conn)
+	go func() {
+		defer close(r.requests)
+		for {
+			var req shared.Request
+			conn.waitRequest()
+			if err := decoder.Decode(&req); err != nil {
+				r.err = err
+				cancel()
+				return
+			}
+			select {
+			case r.requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return r
+}
+
+// processRequest aplica el límite de peticiones del cliente y atiende req
+// dentro del plazo de su operación. Si el plazo vence, la respuesta es un
+// error TIMEOUT sea cual sea el error con el que falló la operación
+// cancelada.
+func (s *Server) processRequest(ctx context.Context, sess *session, req shared.Request) shared.Response {
+	if !s.limiter.allow(rateKey(sess)) {
+		return rateLimitedResponse()
+	}
+	timeout := s.timeouts.forOperation(req.Operation)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	response := s.dispatch(ctx, sess, req)
+	if !response.Success && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return timeoutResponse(req.Operation, timeout)
+	}
+	if sess.user == nil || response.Data == nil {
+		return response
+	}
+	masked, err := shared.MaskFields(response.Data, s.authz.HiddenCategories(ctx, sess.user.Rol))
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Code:    shared.CodeInternal,
+			Message: fmt.Sprintf("Error preparando respuesta: %v", err),
+		}
+	}
+	response.Data = masked
+	return response
+}
+
+func rateLimitedResponse() shared.Response {
+	return shared.Response{
+		Success: false,
+		Code:    shared.CodeRateLimited,
+		Message: "Demasiadas peticiones; espere unos segundos antes de reintentar",
+	}
+}
+
+func timeoutResponse(op string, timeout time.Duration) shared.Response {
+	log.Printf("Operación %s cancelada por exceder %v", op, timeout)
+	return shared.Response{
+		Success: false,
+		Code:    shared.CodeTimeout,
+		Message: fmt.Sprintf("La operación %s excedió el tiempo límite de %v", op, timeout),
+	}
+}
+
+func (s *Server) dispatch(ctx context.Context, sess *session, req shared.Request) shared.Response {
+	switch req.Operation {
+	case "LOGIN":
+		return s.handleLogin(ctx, sess, req.Data)
+	case "LOGOUT":
+		return s.handleLogout(sess)
+	case "PING":
+		return s.handlePing()
+	case "HEALTH":
+		return s.handleHealth(ctx)
+	case "UNSUBSCRIBE":
+		return shared.Response{
+			Success: false,
+			Message: "No hay una suscripción activa",
+		}
+	}
+	if response, ok := s.checkSession(sess); !ok {
+		return response
+	}
+	if isOperacion(req.Operation) {
+		if response, ok := s.authorize(ctx, sess, req.Operation); !ok {
+			return response
+		}
+	}
+	switch req.Operation {
+	case "CREATE_WEBHOOK", "LIST_WEBHOOKS", "UPDATE_WEBHOOK", "DELETE_WEBHOOK", "LIST_ENTREGAS_FALLIDAS", "RETRY_ENTREGA":
+		if s.webhooks == nil {
+			return shared.Response{
+				Success: false,
+				Message: "Los webhooks no están disponibles con este backend de base de datos",
+			}
+		}
+	}
+	switch req.Operation {
+	case "CREATE_USUARIO":
+		return s.handleCreateUsuario(ctx, req.Data)
+	case "UPDATE_USUARIO":
+		return s.handleUpdateUsuario(ctx, req.Data)
+	case "LIST_USUARIOS":
+		return s.handleListUsuarios(ctx)
+	case "LIST_ROLES":
+		return s.handleListRoles(ctx)
+	case "SAVE_ROL":
+		return s.handleSaveRol(ctx, req.Data)
+	case "DELETE_ROL":
+		return s.handleDeleteRol(ctx, req.Data)
+	case "CREATE_WEBHOOK":
+		return s.handleCreateWebhook(ctx, req.Data)
+	case "LIST_WEBHOOKS":
+		return s.handleListWebhooks(ctx)
+	case "UPDATE_WEBHOOK":
+		return s.handleUpdateWebhook(ctx, req.Data)
+	case "DELETE_WEBHOOK":
+		return s.handleDeleteWebhook(ctx, req.Data)
+	case "LIST_ENTREGAS_FALLIDAS":
+		return s.handleListEntregasFallidas(ctx)
+	case "RETRY_ENTREGA":
+		return s.handleRetryEntrega(ctx, req.Data)
+	}
+	crud := s.crud.WithActor(sess.actor(s.authz.HiddenCategories(ctx, sess.user.Rol)))
+	switch req.Operation {
+	case "BATCH":
+		return s.handleBatch(ctx, sess, crud, req.Data)
+	case "SUBSCRIBE":
+		return s.handleSubscribe(ctx, sess, crud, req.Data)
+	}
+	return s.execute(ctx, crud, req)
+}
+
+// execute despacha una operación simple usando crud, que puede estar ligado a
+// la transacción de un BATCH.
+func (s *Server) execute(ctx context.Context, crud *EmpleadoCrud, req shared.Request) shared.Response {
 	switch req.Operation {
 	case "INSERT":
-		return s.handleInsert(req.Data)
+		return s.handleInsert(ctx, crud, req.Data)
 	case "UPDATE":
-		return s.handleUpdate(req.Data)
+		return s.handleUpdate(ctx, crud, req.Data)
+	case "PATCH":
+		return s.handlePatch(ctx, crud, req.Data)
 	case "SELECT":
-		return s.handleSelect(req.Data)
+		return s.handleSelect(ctx, crud, req.Data)
 	case "DELETE":
-		return s.handleDelete(req.Data)
+		return s.handleDelete(ctx, crud, req.Data)
+	case "RESTORE":
+		return s.handleRestore(ctx, crud, req.Data)
+	case "LIST_AUDIT":
+		return s.handleListAudit(ctx, crud, req.Data)
 	case "LIST_CARGOS":
-		return s.handleListCargos()
+		return s.handleListCargos(ctx, crud)
 	case "LIST_DEPARTAMENTOS_CON_DATOS":
-		return s.handleListDepartamentosConDatos()
+		return s.handleListDepartamentosConDatos(ctx, crud)
 	case "LIST_GERENTES":
-		return s.handleListGerentes()
+		return s.handleListGerentes(ctx, crud)
 
 	default:
 		return shared.Response{
 			Success: false,
-			Message: "Operación no válida. Operaciones disponibles: INSERT, UPDATE, SELECT, DELETE, LIST_CARGOS, LIST_CARGOS_CON_DATOS, LIST_DEPARTAMENTOS, LIST_DEPARTAMENTOS_CON_DATOS, LIST_GERENTES",
+			Message: "Operación no válida. Operaciones disponibles: INSERT, UPDATE, PATCH, SELECT, DELETE, RESTORE, BATCH, LIST_AUDIT, SUBSCRIBE, UNSUBSCRIBE, LOGIN, LOGOUT, PING, HEALTH, CREATE_USUARIO, UPDATE_USUARIO, LIST_USUARIOS, LIST_ROLES, SAVE_ROL, DELETE_ROL, CREATE_WEBHOOK, LIST_WEBHOOKS, UPDATE_WEBHOOK, DELETE_WEBHOOK, LIST_ENTREGAS_FALLIDAS, RETRY_ENTREGA, LIST_CARGOS, LIST_DEPARTAMENTOS_CON_DATOS, LIST_GERENTES",
 		}
 	}
 }
 
-func (s *Server) handleInsert(data interface{}) shared.Response {
+func (s *Server) handleInsert(ctx context.Context, crud *EmpleadoCrud, data interface{}) shared.Response {
 	var dto shared.CreateEmpleadoDTO
 	jsonData, err := json.Marshal(data)
 	if err != nil {
@@ -130,12 +529,9 @@ func (s *Server) handleInsert(data interface{}) shared.Response {
 			Message: fmt.Sprintf("Error en formato de datos: %v", err),
 		}
 	}
-	result, err := s.crud.Insert(dto)
+	result, err := crud.Insert(ctx, dto)
 	if err != nil {
-		return shared.Response{
-			Success: false,
-			Message: err.Error(),
-		}
+		return errorResponse(err)
 	}
 	return shared.Response{
 		Success: true,
@@ -144,7 +540,7 @@ func (s *Server) handleInsert(data interface{}) shared.Response {
 	}
 }
 
-func (s *Server) handleUpdate(data interface{}) shared.Response {
+func (s *Server) handleUpdate(ctx context.Context, crud *EmpleadoCrud, data interface{}) shared.Response {
 	var dto shared.UpdateEmpleadoDTO
 	jsonData, err := json.Marshal(data)
 	if err != nil {
@@ -159,21 +555,44 @@ func (s *Server) handleUpdate(data interface{}) shared.Response {
 			Message: fmt.Sprintf("Error en formato de datos: %v", err),
 		}
 	}
-	result, err := s.crud.Update(dto)
+	result, err := crud.Update(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Empleado actualizado exitosamente",
+		Data:    result,
+	}
+}
+
+func (s *Server) handlePatch(ctx context.Context, crud *EmpleadoCrud, data interface{}) shared.Response {
+	var dto shared.PatchEmpleadoDTO
+	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return shared.Response{
 			Success: false,
-			Message: err.Error(),
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
 		}
 	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	result, err := crud.Patch(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
 	return shared.Response{
 		Success: true,
-		Message: "Empleado actualizado exitosamente",
+		Message: "Empleado actualizado parcialmente",
 		Data:    result,
 	}
 }
 
-func (s *Server) handleSelect(data interface{}) shared.Response {
+func (s *Server) handleSelect(ctx context.Context, crud *EmpleadoCrud, data interface{}) shared.Response {
 	dataMap, ok := data.(map[string]interface{})
 	if !ok {
 		return shared.Response{
@@ -189,12 +608,9 @@ func (s *Server) handleSelect(data interface{}) shared.Response {
 		}
 	}
 	id := int(idFloat)
-	result, err := s.crud.Select(id)
+	result, err := crud.Select(ctx, id)
 	if err != nil {
-		return shared.Response{
-			Success: false,
-			Message: err.Error(),
-		}
+		return errorResponse(err)
 	}
 	return shared.Response{
 		Success: true,
@@ -203,7 +619,7 @@ func (s *Server) handleSelect(data interface{}) shared.Response {
 	}
 }
 
-func (s *Server) handleDelete(data interface{}) shared.Response {
+func (s *Server) handleDelete(ctx context.Context, crud *EmpleadoCrud, data interface{}) shared.Response {
 	dataMap, ok := data.(map[string]interface{})
 	if !ok {
 		return shared.Response{
@@ -218,28 +634,82 @@ func (s *Server) handleDelete(data interface{}) shared.Response {
 			Message: "ID del empleado es requerido para DELETE",
 		}
 	}
-	id := int(idFloat)
-	err := s.crud.Delete(id)
-	if err != nil {
+	versionFloat, ok := dataMap["empl_version"].(float64)
+	if !ok {
 		return shared.Response{
 			Success: false,
-			Message: err.Error(),
+			Message: "Versión del empleado es requerida para DELETE",
 		}
 	}
+	err := crud.Delete(ctx, int(idFloat), int(versionFloat))
+	if err != nil {
+		return errorResponse(err)
+	}
 	return shared.Response{
 		Success: true,
 		Message: "Empleado eliminado exitosamente y guardado en histórico",
 	}
 }
 
-func (s *Server) handleListCargos() shared.Response {
-	cargos, err := s.crud.ListCargos()
+func (s *Server) handleRestore(ctx context.Context, crud *EmpleadoCrud, data interface{}) shared.Response {
+	var dto shared.RestoreEmpleadoDTO
+	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return shared.Response{
 			Success: false,
-			Message: err.Error(),
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
 		}
 	}
+	result, err := crud.Restore(ctx, dto.ID, dto.Version)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Empleado restaurado exitosamente",
+		Data:    result,
+	}
+}
+
+func (s *Server) handleListAudit(ctx context.Context, crud *EmpleadoCrud, data interface{}) shared.Response {
+	var dto shared.ListAuditDTO
+	if data != nil {
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return shared.Response{
+				Success: false,
+				Message: fmt.Sprintf("Error procesando datos: %v", err),
+			}
+		}
+		if err := json.Unmarshal(jsonData, &dto); err != nil {
+			return shared.Response{
+				Success: false,
+				Message: fmt.Sprintf("Error en formato de datos: %v", err),
+			}
+		}
+	}
+	entries, err := crud.ListAudit(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Registros de auditoría obtenidos",
+		Data:    entries,
+	}
+}
+
+func (s *Server) handleListCargos(ctx context.Context, crud *EmpleadoCrud) shared.Response {
+	cargos, err := crud.ListCargos(ctx)
+	if err != nil {
+		return errorResponse(err)
+	}
 	return shared.Response{
 		Success: true,
 		Message: "Lista de cargos obtenida",
@@ -247,13 +717,10 @@ func (s *Server) handleListCargos() shared.Response {
 	}
 }
 
-func (s *Server) handleListDepartamentosConDatos() shared.Response {
-	departamentos, err := s.crud.ListDepartamentosConDatos()
+func (s *Server) handleListDepartamentosConDatos(ctx context.Context, crud *EmpleadoCrud) shared.Response {
+	departamentos, err := crud.ListDepartamentosConDatos(ctx)
 	if err != nil {
-		return shared.Response{
-			Success: false,
-			Message: err.Error(),
-		}
+		return errorResponse(err)
 	}
 	return shared.Response{
 		Success: true,
@@ -262,13 +729,10 @@ func (s *Server) handleListDepartamentosConDatos() shared.Response {
 	}
 }
 
-func (s *Server) handleListGerentes() shared.Response {
-	gerentes, err := s.crud.ListGerentes()
+func (s *Server) handleListGerentes(ctx context.Context, crud *EmpleadoCrud) shared.Response {
+	gerentes, err := crud.ListGerentes(ctx)
 	if err != nil {
-		return shared.Response{
-			Success: false,
-			Message: err.Error(),
-		}
+		return errorResponse(err)
 	}
 	return shared.Response{
 		Success: true,
@@ -278,11 +742,62 @@ func (s *Server) handleListGerentes() shared.Response {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			log.Fatalf("Error en migración: %v", err)
+		}
+		return
+	}
 	port := os.Getenv("SERVER_PORT")
 	server := NewServer(port)
+	if v := os.Getenv("SESSION_IDLE_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("SESSION_IDLE_TIMEOUT inválido: %v", err)
+		}
+		server.sessionIdleTimeout = timeout
+	}
+	timeouts, err := requestTimeoutsFromEnv()
+	if err != nil {
+		log.Fatalf("Plazos de operación inválidos: %v", err)
+	}
+	server.timeouts = timeouts
+	limits, err := connLimitsFromEnv()
+	if err != nil {
+		log.Fatalf("Límites de conexión inválidos: %v", err)
+	}
+	server.limits = limits
+	server.limiter = newRateLimiter(limits.rate, limits.burst)
+	tlsConfig, err := buildTLSConfig(tlsOptionsFromEnv())
+	if err != nil {
+		log.Fatalf("Configuración TLS inválida: %v", err)
+	}
+	server.tlsConfig = tlsConfig
+	server.httpPort = os.Getenv("HTTP_PORT")
+	server.grpcPort = os.Getenv("GRPC_PORT")
+	server.autoMigrate = os.Getenv("HR_AUTO_MIGRATE") == "true"
+	if v := os.Getenv("DB_CHECK_INTERVAL"); v != "" {
+		interval, err := parseTimeout(v)
+		if err != nil {
+			log.Fatalf("DB_CHECK_INTERVAL inválido: %v", err)
+		}
+		server.dbCheckInterval = interval
+	}
+	if v := os.Getenv("SHUTDOWN_GRACE_PERIOD"); v != "" {
+		grace, err := parseTimeout(v)
+		if err != nil {
+			log.Fatalf("SHUTDOWN_GRACE_PERIOD inválido: %v", err)
+		}
+		server.shutdownGrace = grace
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	// Tras la primera señal se restaura el manejo por defecto, así que una
+	// segunda termina el proceso sin esperar el apagado.
+	context.AfterFunc(ctx, stop)
 	log.Println("=== SERVIDOR DE RECURSOS HUMANOS ===")
 	log.Println("Iniciando servidor...")
-	if err := server.Start(); err != nil {
+	if err := server.Start(ctx); err != nil {
 		log.Fatalf("Error iniciando servidor: %v", err)
 	}
 }
@@ -0,0 +1,649 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"hr-system/shared"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testStore es un EmpleadoStore en el que las pruebas pueden crear cargos y
+// departamentos.
+type testStore interface {
+	EmpleadoStore
+	AddCargo(nombre string, sueldoMinimo, sueldoMaximo float64) (int, error)
+	AddDepartamento(nombre, direccion, ciudad string) (int, error)
+}
+
+// testBackends son los stores sobre los que corren las pruebas del protocolo;
+// todas deben pasar igual con cualquiera de ellos.
+var testBackends = []struct {
+	name string
+	open func(t *testing.T) testStore
+}{
+	{"memoria", func(*testing.T) testStore { return NewMemoryStore() }},
+	{"sqlite", newSQLiteTestStore},
+	{"postgres", newPostgresTestStore},
+}
+
+// forEachBackend ejecuta test con un fixture nuevo sobre cada backend.
+func forEachBackend(t *testing.T, test func(t *testing.T, f *testFixture)) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			test(t, newTestFixture(t, backend.open(t)))
+		})
+	}
+}
+
+// sqlTestStore es un SQLStore sin los empleados ni los catálogos de los
+// datos iniciales, para que las pruebas partan de cero.
+type sqlTestStore struct {
+	*SQLStore
+	db *sql.DB
+}
+
+func newSQLTestStore(t *testing.T, db *sql.DB, store *SQLStore) *sqlTestStore {
+	t.Helper()
+	for _, table := range []string{"empleados", "departamentos", "cargos"} {
+		if _, err := db.Exec("DELETE FROM " + table); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &sqlTestStore{SQLStore: store, db: db}
+}
+
+func (s *sqlTestStore) AddCargo(nombre string, sueldoMinimo, sueldoMaximo float64) (int, error) {
+	var id int
+	err := s.db.QueryRow(`
+		INSERT INTO cargos (cargo_nombre, cargo_sueldo_minimo, cargo_sueldo_maximo)
+		VALUES ($1, $2, $3) RETURNING cargo_ID`, nombre, sueldoMinimo, sueldoMaximo).Scan(&id)
+	return id, err
+}
+
+func (s *sqlTestStore) AddDepartamento(nombre, direccion, ciudad string) (int, error) {
+	var id int
+	err := s.db.QueryRow(`
+		INSERT INTO ciudades (ciud_pais_ID, ciud_nombre)
+		VALUES ((SELECT MIN(pais_ID) FROM paises), $1) RETURNING ciud_ID`, ciudad).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	err = s.db.QueryRow(`
+		INSERT INTO localizaciones (localiz_ciudad_ID, localiz_direccion)
+		VALUES ($1, $2) RETURNING localiz_ID`, id, direccion).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	err = s.db.QueryRow(`
+		INSERT INTO departamentos (dpto_localiz_ID, dpto_nombre)
+		VALUES ($1, $2) RETURNING dpto_ID`, id, nombre).Scan(&id)
+	return id, err
+}
+
+// testFixture es un servidor sobre un testStore con dos departamentos y dos
+// cargos.
+type testFixture struct {
+	server   *Server
+	store    testStore
+	authzDB  *sql.DB
+	ventas   int
+	sistemas int
+	analista int
+	gerente  int
+}
+
+func newTestFixture(t *testing.T, store testStore) *testFixture {
+	t.Helper()
+	f := &testFixture{store: store}
+	var err error
+	if f.ventas, err = store.AddDepartamento("Ventas", "Av. Principal 100", "Lima"); err != nil {
+		t.Fatal(err)
+	}
+	if f.sistemas, err = store.AddDepartamento("Sistemas", "Calle 8 #20", "Bogotá"); err != nil {
+		t.Fatal(err)
+	}
+	if f.analista, err = store.AddCargo("Analista", 1000, 5000); err != nil {
+		t.Fatal(err)
+	}
+	if f.gerente, err = store.AddCargo("Gerente", 4000, 12000); err != nil {
+		t.Fatal(err)
+	}
+	f.server = NewServer("0")
+	f.server.crud = NewEmpleadoCrud(store)
+	// La matriz de permisos es la de los datos iniciales de las migraciones,
+	// aunque el store de la prueba no use base de datos.
+	f.authzDB = openSQLiteTestDB(t)
+	f.server.authz = NewAuthorizer(f.authzDB)
+	if err := f.server.authz.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// grant concede permiso al rol en la matriz del fixture.
+func (f *testFixture) grant(t *testing.T, rol, permiso string) {
+	t.Helper()
+	_, err := f.authzDB.Exec(`INSERT INTO rol_permisos (rolperm_rol, rolperm_permiso) VALUES ($1, $2)`, rol, permiso)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.server.authz.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestSession(rol, alcance string, emplID *int) *session {
+	return &session{
+		clientAddr:   "test",
+		user:         &usuarioAutenticado{Usuario: rol + "_user", Rol: rol, Alcance: alcance, EmplID: emplID},
+		lastActivity: time.Now(),
+	}
+}
+
+// call envía data por JSON, como llegaría por el socket.
+func (f *testFixture) call(t *testing.T, sess *session, op string, data any) shared.Response {
+	t.Helper()
+	var wire any
+	if data != nil {
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(jsonData, &wire); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f.server.processRequest(context.Background(), sess, shared.Request{Operation: op, Data: wire})
+}
+
+func (f *testFixture) mustCall(t *testing.T, sess *session, op string, data any, out any) {
+	t.Helper()
+	response := f.call(t, sess, op, data)
+	if !response.Success {
+		t.Fatalf("%s falló: %s (%s)", op, response.Message, response.Code)
+	}
+	if out != nil {
+		decodeData(t, response.Data, out)
+	}
+}
+
+func decodeData(t *testing.T, data any, out any) {
+	t.Helper()
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(jsonData, out); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (f *testFixture) nuevoEmpleado(email string, dpto int, gerente *int) shared.CreateEmpleadoDTO {
+	return shared.CreateEmpleadoDTO{
+		PrimerNombre: "Ana",
+		Email:        email,
+		FechaNac:     "1990-05-15",
+		Sueldo:       2500,
+		Comision:     5,
+		CargoID:      f.analista,
+		GerenteID:    gerente,
+		DptoID:       dpto,
+	}
+}
+
+func (f *testFixture) insert(t *testing.T, dto shared.CreateEmpleadoDTO) shared.CreateEmpleadoResponseDTO {
+	t.Helper()
+	var created shared.CreateEmpleadoResponseDTO
+	f.mustCall(t, newTestSession("hr_manager", shared.AlcanceTodos, nil), "INSERT", dto, &created)
+	return created
+}
+
+func expectError(t *testing.T, response shared.Response, code, message string) {
+	t.Helper()
+	if response.Success {
+		t.Fatalf("se esperaba error %q, la operación tuvo éxito", message)
+	}
+	if response.Code != code {
+		t.Errorf("código %q, se esperaba %q (%s)", response.Code, code, response.Message)
+	}
+	if !strings.Contains(response.Message, message) {
+		t.Errorf("mensaje %q, se esperaba que contuviera %q", response.Message, message)
+	}
+}
+
+func TestProcessRequestRequiresSession(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		sess := &session{clientAddr: "test"}
+		expectError(t, f.call(t, sess, "SELECT", map[string]any{"empl_id": 1}),
+			shared.CodeUnauthorized, "Debe iniciar sesión")
+
+		expired := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		expired.lastActivity = time.Now().Add(-2 * defaultSessionIdleTimeout)
+		expectError(t, f.call(t, expired, "LIST_CARGOS", nil), shared.CodeUnauthorized, "expirada")
+		if expired.user != nil {
+			t.Error("la sesión expirada conserva el usuario")
+		}
+
+		active := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		f.mustCall(t, active, "LOGOUT", nil, nil)
+		expectError(t, f.call(t, active, "LIST_CARGOS", nil), shared.CodeUnauthorized, "Debe iniciar sesión")
+
+		expectError(t, f.call(t, active, "UNSUBSCRIBE", nil), "", "No hay una suscripción activa")
+	})
+}
+
+func TestProcessRequestChecksPermissions(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		analyst := newTestSession("hr_analyst", shared.AlcanceTodos, nil)
+		for _, op := range []string{"INSERT", "UPDATE", "PATCH", "DELETE", "RESTORE", "BATCH"} {
+			expectError(t, f.call(t, analyst, op, map[string]any{}), shared.CodeForbidden, op)
+		}
+		// Cada paso de BATCH requiere además su propio permiso.
+		f.grant(t, "hr_analyst", "BATCH")
+		expectError(t, f.call(t, analyst, "BATCH", shared.BatchRequestDTO{Steps: []shared.BatchStepDTO{
+			{Operation: "LIST_CARGOS"},
+			{Operation: "DELETE", Data: map[string]any{"empl_id": 1, "empl_version": 1}},
+		}}), shared.CodeForbidden, "paso 2")
+
+		admin := newTestSession(shared.RolAdmin, shared.AlcanceTodos, nil)
+		response := f.call(t, admin, "NO_EXISTE", nil)
+		expectError(t, response, "", "Operación no válida")
+		// El mensaje enumera exactamente las operaciones con permiso y las
+		// que no lo requieren.
+		_, listed, _ := strings.Cut(response.Message, "Operaciones disponibles: ")
+		want := append([]string{"LOGIN", "LOGOUT", "PING", "HEALTH", "UNSUBSCRIBE"}, operaciones...)
+		got := strings.Split(listed, ", ")
+		sort.Strings(want)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("operaciones disponibles = %v, se esperaba %v", got, want)
+		}
+	})
+}
+
+func TestProcessRequestTimeout(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		f.server.timeouts = requestTimeouts{
+			defecto:      time.Minute,
+			porOperacion: map[string]time.Duration{"INSERT": time.Nanosecond},
+		}
+		expectError(t, f.call(t, manager, "INSERT", f.nuevoEmpleado("ana@empresa.com", f.ventas, nil)),
+			shared.CodeTimeout, "excedió el tiempo límite")
+
+		// El INSERT cancelado no dejó nada: el mismo email puede registrarse.
+		f.server.timeouts = defaultRequestTimeouts()
+		f.insert(t, f.nuevoEmpleado("ana@empresa.com", f.ventas, nil))
+	})
+}
+
+func TestInsertAndSelect(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+
+		jefe := f.insert(t, f.nuevoEmpleado("jefe@empresa.com", f.ventas, nil))
+		if jefe.ID <= 0 || jefe.Version != 1 || jefe.DepartamentoNombre != "Ventas" || jefe.Ciudad != "Lima" {
+			t.Fatalf("resumen inesperado: %+v", jefe)
+		}
+		dto := f.nuevoEmpleado("  Luis@Empresa.com ", f.ventas, &jefe.ID)
+		dto.PrimerNombre = "Luis"
+		luis := f.insert(t, dto)
+		if luis.GerenteNombre == nil || *luis.GerenteNombre != "Ana " {
+			t.Errorf("gerente_nombre = %v", luis.GerenteNombre)
+		}
+
+		var detail shared.EmpleadoDetailResponseDTO
+		f.mustCall(t, manager, "SELECT", map[string]any{"empl_id": luis.ID}, &detail)
+		if detail.Email != "Luis@Empresa.com" || detail.CargoNombre != "Analista" ||
+			detail.GerenteID == nil || *detail.GerenteID != jefe.ID || detail.IsDeleted {
+			t.Errorf("detalle inesperado: %+v", detail)
+		}
+		if !strings.HasPrefix(detail.FechaNac, "1990-05-15") {
+			t.Errorf("fecha_nac = %q", detail.FechaNac)
+		}
+
+		expectError(t, f.call(t, manager, "INSERT", f.nuevoEmpleado("jefe@empresa.com", f.ventas, nil)),
+			"", "email ya existe")
+		expectError(t, f.call(t, manager, "INSERT", f.nuevoEmpleado("otro@empresa.com", 99, nil)),
+			"", "ID de cargo, gerente o departamento no válido")
+		noGerente := 99
+		expectError(t, f.call(t, manager, "INSERT", f.nuevoEmpleado("otro@empresa.com", f.ventas, &noGerente)),
+			"", "ID de cargo, gerente o departamento no válido")
+		invalid := f.nuevoEmpleado("otro@empresa.com", f.ventas, nil)
+		invalid.FechaNac = "15/05/1990"
+		expectError(t, f.call(t, manager, "INSERT", invalid), "", "validación fallida")
+
+		expectError(t, f.call(t, manager, "SELECT", map[string]any{"empl_id": 999}),
+			shared.CodeNotFound, "empleado no encontrado")
+		expectError(t, f.call(t, manager, "SELECT", map[string]any{}), "", "ID del empleado es requerido")
+	})
+}
+
+func TestUpdateAndPatchUseOptimisticLocking(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		jefe := f.insert(t, f.nuevoEmpleado("jefe@empresa.com", f.ventas, nil))
+		emp := f.insert(t, f.nuevoEmpleado("ana@empresa.com", f.ventas, &jefe.ID))
+
+		segundo := "María"
+		update := shared.UpdateEmpleadoDTO{
+			ID: emp.ID, PrimerNombre: "Ana", SegundoNombre: &segundo, Email: "ana@empresa.com",
+			FechaNac: "1990-05-15", Sueldo: 3000, Comision: 5, CargoID: f.gerente, DptoID: f.sistemas,
+			Version: emp.Version,
+		}
+		var updated shared.UpdateEmpleadoResponseDTO
+		f.mustCall(t, manager, "UPDATE", update, &updated)
+		if updated.Version != 2 || updated.CargoNombre != "Gerente" || updated.GerenteNombre != nil ||
+			updated.SegundoNombre == nil || *updated.SegundoNombre != "María" {
+			t.Errorf("UPDATE no reemplazó todos los campos: %+v", updated)
+		}
+
+		response := f.call(t, manager, "UPDATE", update)
+		expectError(t, response, shared.CodeConflict, "versión actual 2")
+		var current shared.EmpleadoDetailResponseDTO
+		decodeData(t, response.Data, &current)
+		if current.Version != 2 {
+			t.Errorf("el conflicto no devuelve la fila actual: %+v", current)
+		}
+
+		sueldo := 3500.555
+		f.mustCall(t, manager, "PATCH", shared.PatchEmpleadoDTO{
+			ID: emp.ID, Sueldo: &sueldo, LimpiarSegundoNombre: true, Version: 2,
+		}, &updated)
+		if updated.Version != 3 || updated.Sueldo != 3500.56 || updated.SegundoNombre != nil ||
+			updated.CargoNombre != "Gerente" {
+			t.Errorf("PATCH inesperado: %+v", updated)
+		}
+
+		expectError(t, f.call(t, manager, "PATCH", shared.PatchEmpleadoDTO{ID: emp.ID, Version: 3}),
+			"", "no se enviaron campos")
+		email := "jefe@empresa.com"
+		expectError(t, f.call(t, manager, "PATCH", shared.PatchEmpleadoDTO{ID: emp.ID, Email: &email, Version: 3}),
+			"", "email ya existe")
+		expectError(t, f.call(t, manager, "PATCH", shared.PatchEmpleadoDTO{ID: 999, Sueldo: &sueldo, Version: 1}),
+			shared.CodeNotFound, "empleado no encontrado")
+
+		update.GerenteID, update.Version = &emp.ID, 3
+		expectError(t, f.call(t, manager, "UPDATE", update), "", "su propio gerente")
+		expectError(t, f.call(t, manager, "PATCH", shared.PatchEmpleadoDTO{ID: emp.ID, GerenteID: &emp.ID, Version: 3}),
+			"", "su propio gerente")
+	})
+}
+
+func TestDeleteAndRestore(t *testing.T) {
+	ctx := context.Background()
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		emp := f.insert(t, f.nuevoEmpleado("ana@empresa.com", f.ventas, nil))
+
+		expectError(t, f.call(t, manager, "DELETE", map[string]any{"empl_id": emp.ID, "empl_version": 5}),
+			shared.CodeConflict, "versión actual 1")
+		expectError(t, f.call(t, manager, "DELETE", map[string]any{"empl_id": emp.ID}),
+			"", "Versión del empleado es requerida")
+		f.mustCall(t, manager, "DELETE", map[string]any{"empl_id": emp.ID, "empl_version": 1}, nil)
+
+		historico, err := f.store.ListHistorico(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(historico) != 1 || historico[0].CargoID != f.analista || historico[0].DptoID != f.ventas {
+			t.Errorf("histórico inesperado: %+v", historico)
+		}
+
+		var detail shared.EmpleadoDetailResponseDTO
+		f.mustCall(t, manager, "SELECT", map[string]any{"empl_id": emp.ID}, &detail)
+		if !detail.IsDeleted || detail.Version != 2 {
+			t.Errorf("borrado lógico inesperado: %+v", detail)
+		}
+		expectError(t, f.call(t, manager, "DELETE", map[string]any{"empl_id": emp.ID, "empl_version": 2}),
+			shared.CodeNotFound, "ya está eliminado")
+		sueldo := 3000.0
+		expectError(t, f.call(t, manager, "PATCH", shared.PatchEmpleadoDTO{ID: emp.ID, Sueldo: &sueldo, Version: 2}),
+			shared.CodeNotFound, "ya está eliminado")
+		expectError(t, f.call(t, manager, "INSERT", f.nuevoEmpleado("ana@empresa.com", f.ventas, nil)),
+			"", "email ya existe")
+
+		expectError(t, f.call(t, manager, "RESTORE", shared.RestoreEmpleadoDTO{ID: emp.ID, Version: 1}),
+			shared.CodeConflict, "versión actual 2")
+		var restored shared.UpdateEmpleadoResponseDTO
+		f.mustCall(t, manager, "RESTORE", shared.RestoreEmpleadoDTO{ID: emp.ID, Version: 2}, &restored)
+		if restored.Version != 3 {
+			t.Errorf("versión restaurada = %d", restored.Version)
+		}
+		expectError(t, f.call(t, manager, "RESTORE", shared.RestoreEmpleadoDTO{ID: emp.ID, Version: 3}),
+			"", "no está eliminado")
+		expectError(t, f.call(t, manager, "RESTORE", shared.RestoreEmpleadoDTO{ID: 999, Version: 1}),
+			shared.CodeNotFound, "empleado no encontrado")
+	})
+}
+
+func TestListOperations(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		ana := f.insert(t, f.nuevoEmpleado("ana@empresa.com", f.ventas, nil))
+		luis := f.insert(t, f.nuevoEmpleado("luis@empresa.com", f.sistemas, nil))
+		f.mustCall(t, manager, "DELETE", map[string]any{"empl_id": luis.ID, "empl_version": 1}, nil)
+
+		var cargos []shared.CargoDTO
+		f.mustCall(t, manager, "LIST_CARGOS", nil, &cargos)
+		if len(cargos) != 2 || cargos[0].Nombre != "Analista" || cargos[1].Nombre != "Gerente" {
+			t.Errorf("cargos: %+v", cargos)
+		}
+
+		var departamentos []map[string]any
+		f.mustCall(t, manager, "LIST_DEPARTAMENTOS_CON_DATOS", nil, &departamentos)
+		if len(departamentos) != 2 || departamentos[1]["dpto_nombre"] != "Sistemas" ||
+			departamentos[1]["ciudad"] != "Bogotá" {
+			t.Errorf("departamentos: %+v", departamentos)
+		}
+
+		var gerentes []shared.GerenteDTO
+		f.mustCall(t, manager, "LIST_GERENTES", nil, &gerentes)
+		if len(gerentes) != 1 || gerentes[0].ID != ana.ID {
+			t.Errorf("LIST_GERENTES incluye empleados eliminados: %+v", gerentes)
+		}
+	})
+}
+
+func TestListAudit(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		ana := f.insert(t, f.nuevoEmpleado("ana@empresa.com", f.ventas, nil))
+		luis := f.insert(t, f.nuevoEmpleado("luis@empresa.com", f.ventas, nil))
+		f.mustCall(t, manager, "DELETE", map[string]any{"empl_id": ana.ID, "empl_version": 1}, nil)
+
+		var entries []shared.AuditEntryDTO
+		f.mustCall(t, manager, "LIST_AUDIT", nil, &entries)
+		if len(entries) != 3 || entries[0].Operacion != AuditDelete || entries[2].Operacion != AuditInsert {
+			t.Fatalf("auditoría: %+v", entries)
+		}
+		if entries[0].Operador != "hr_manager_user" || entries[0].Cliente != "test" {
+			t.Errorf("actor no registrado: %+v", entries[0])
+		}
+		var antes, despues map[string]any
+		decodeData(t, entries[0].Antes, &antes)
+		decodeData(t, entries[0].Despues, &despues)
+		if antes["is_deleted"] != false || despues["is_deleted"] != true || despues["empl_version"] != 2.0 {
+			t.Errorf("antes %v, después %v", antes, despues)
+		}
+
+		f.mustCall(t, manager, "LIST_AUDIT", shared.ListAuditDTO{EmplID: &luis.ID}, &entries)
+		if len(entries) != 1 || *entries[0].EmplID != luis.ID {
+			t.Errorf("filtro por empleado: %+v", entries)
+		}
+		f.mustCall(t, manager, "LIST_AUDIT", shared.ListAuditDTO{Limit: 2}, &entries)
+		if len(entries) != 2 {
+			t.Errorf("limit: %d registros", len(entries))
+		}
+		f.mustCall(t, manager, "LIST_AUDIT", shared.ListAuditDTO{Operador: "otro"}, &entries)
+		if len(entries) != 0 {
+			t.Errorf("filtro por operador: %+v", entries)
+		}
+		hoy := time.Now().Format(shared.FechaLayout)
+		f.mustCall(t, manager, "LIST_AUDIT", shared.ListAuditDTO{Desde: hoy, Hasta: hoy}, &entries)
+		if len(entries) != 3 {
+			t.Errorf("filtro por fecha: %d registros", len(entries))
+		}
+		expectError(t, f.call(t, manager, "LIST_AUDIT", shared.ListAuditDTO{Desde: "ayer"}), "", "fecha 'desde' inválida")
+	})
+}
+
+func TestBatch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+
+		subordinado := f.nuevoEmpleado("luis@empresa.com", f.ventas, nil)
+		var result shared.BatchResponseDTO
+		f.mustCall(t, manager, "BATCH", shared.BatchRequestDTO{Steps: []shared.BatchStepDTO{
+			{Ref: "jefe", Operation: "INSERT", Data: f.nuevoEmpleado("jefe@empresa.com", f.ventas, nil)},
+			{Operation: "INSERT", Data: map[string]any{
+				"empl_primer_nombre": "Luis", "empl_email": subordinado.Email, "empl_fecha_nac": "1992-01-01",
+				"empl_sueldo": 2000, "empl_cargo_id": f.analista, "empl_dpto_id": f.ventas,
+				"empl_gerente_id": "$jefe.empl_id",
+			}},
+		}}, &result)
+		if !result.Committed || len(result.Steps) != 2 {
+			t.Fatalf("BATCH: %+v", result)
+		}
+		var luis shared.CreateEmpleadoResponseDTO
+		decodeData(t, result.Steps[1].Data, &luis)
+		if luis.GerenteNombre == nil {
+			t.Error("la referencia $jefe.empl_id no se resolvió")
+		}
+
+		response := f.call(t, manager, "BATCH", shared.BatchRequestDTO{Steps: []shared.BatchStepDTO{
+			{Operation: "INSERT", Data: f.nuevoEmpleado("nuevo@empresa.com", f.ventas, nil)},
+			{Operation: "INSERT", Data: f.nuevoEmpleado("jefe@empresa.com", f.ventas, nil)},
+		}})
+		expectError(t, response, "", "paso 2 (INSERT) falló: email ya existe")
+		// El primer paso se revirtió: su email sigue libre.
+		f.insert(t, f.nuevoEmpleado("nuevo@empresa.com", f.ventas, nil))
+
+		expectError(t, f.call(t, manager, "BATCH", shared.BatchRequestDTO{}), "", "al menos una operación")
+		expectError(t, f.call(t, manager, "BATCH", shared.BatchRequestDTO{Steps: []shared.BatchStepDTO{
+			{Operation: "BATCH"},
+		}}), "", "no puede anidarse")
+		expectError(t, f.call(t, manager, "BATCH", shared.BatchRequestDTO{Steps: []shared.BatchStepDTO{
+			{Operation: "SELECT", Data: map[string]any{"empl_id": "$nada.empl_id"}},
+		}}), "", "referencia 'nada' desconocida")
+	})
+}
+
+func TestScopeLimitsDepartmentManagers(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		jefe := f.insert(t, f.nuevoEmpleado("jefe@empresa.com", f.ventas, nil))
+		colega := f.insert(t, f.nuevoEmpleado("colega@empresa.com", f.ventas, nil))
+		remoto := f.insert(t, f.nuevoEmpleado("remoto@empresa.com", f.sistemas, &jefe.ID))
+		ajeno := f.insert(t, f.nuevoEmpleado("ajeno@empresa.com", f.sistemas, nil))
+
+		dm := newTestSession("department_manager", shared.AlcanceDepartamento, &jefe.ID)
+		for _, id := range []int{jefe.ID, colega.ID, remoto.ID} {
+			f.mustCall(t, dm, "SELECT", map[string]any{"empl_id": id}, nil)
+		}
+		expectError(t, f.call(t, dm, "SELECT", map[string]any{"empl_id": ajeno.ID}),
+			shared.CodeNotFound, "empleado no encontrado")
+		sueldo := 3000.0
+		expectError(t, f.call(t, dm, "PATCH", shared.PatchEmpleadoDTO{ID: ajeno.ID, Sueldo: &sueldo, Version: 1}),
+			shared.CodeNotFound, "empleado no encontrado")
+
+		// Su propio registro está en alcance para leerlo, no para modificarlo.
+		nombre := "Otro"
+		expectError(t, f.call(t, dm, "PATCH", shared.PatchEmpleadoDTO{ID: jefe.ID, PrimerNombre: &nombre, Version: 1}),
+			shared.CodeForbidden, "propio registro")
+		f.grant(t, "department_manager", "DELETE")
+		expectError(t, f.call(t, dm, "DELETE", map[string]any{"empl_id": jefe.ID, "empl_version": 1}),
+			shared.CodeForbidden, "propio registro")
+
+		// Sin VER_COMPENSACION no puede fijar sueldo ni comisión.
+		comision := 10.0
+		expectError(t, f.call(t, dm, "PATCH", shared.PatchEmpleadoDTO{ID: colega.ID, Sueldo: &sueldo, Version: 1}),
+			shared.CodeForbidden, shared.PermisoVerCompensacion)
+		expectError(t, f.call(t, dm, "PATCH", shared.PatchEmpleadoDTO{ID: colega.ID, Comision: &comision, Version: 1}),
+			shared.CodeForbidden, shared.PermisoVerCompensacion)
+		var patched shared.UpdateEmpleadoResponseDTO
+		f.mustCall(t, dm, "PATCH", shared.PatchEmpleadoDTO{ID: colega.ID, PrimerNombre: &nombre, Version: 1}, &patched)
+		colega.Version = patched.Version
+
+		var gerentes []shared.GerenteDTO
+		f.mustCall(t, dm, "LIST_GERENTES", nil, &gerentes)
+		if len(gerentes) != 3 {
+			t.Errorf("LIST_GERENTES con alcance: %+v", gerentes)
+		}
+
+		// Mover al colega fuera del departamento lo dejaría fuera de alcance.
+		expectError(t, f.call(t, dm, "PATCH", shared.PatchEmpleadoDTO{ID: colega.ID, DptoID: &f.sistemas, Version: colega.Version}),
+			"", errFueraDeAlcance.Error())
+		var detail shared.EmpleadoDetailResponseDTO
+		f.mustCall(t, dm, "SELECT", map[string]any{"empl_id": colega.ID}, &detail)
+		if detail.DptoID != f.ventas || detail.Version != colega.Version {
+			t.Errorf("el PATCH rechazado no se revirtió: %+v", detail)
+		}
+
+		// department_manager no tiene LIST_AUDIT; el alcance se aplica igual a
+		// otro rol con alcance de departamento.
+		expectError(t, f.call(t, dm, "LIST_AUDIT", nil), shared.CodeForbidden, "LIST_AUDIT")
+		analistaDpto := newTestSession("hr_analyst", shared.AlcanceDepartamento, &jefe.ID)
+		var entries []shared.AuditEntryDTO
+		f.mustCall(t, analistaDpto, "LIST_AUDIT", nil, &entries)
+		if len(entries) == 0 {
+			t.Error("LIST_AUDIT no muestra los empleados en alcance")
+		}
+		for _, entry := range entries {
+			if *entry.EmplID == ajeno.ID {
+				t.Error("LIST_AUDIT muestra empleados fuera de alcance")
+			}
+		}
+
+		propio := newTestSession("department_manager", shared.AlcancePropio, &colega.ID)
+		f.mustCall(t, propio, "SELECT", map[string]any{"empl_id": colega.ID}, nil)
+		expectError(t, f.call(t, propio, "SELECT", map[string]any{"empl_id": jefe.ID}),
+			shared.CodeNotFound, "empleado no encontrado")
+		sinEmpleado := newTestSession("department_manager", shared.AlcancePropio, nil)
+		expectError(t, f.call(t, sinEmpleado, "SELECT", map[string]any{"empl_id": colega.ID}),
+			shared.CodeNotFound, "empleado no encontrado")
+	})
+}
+
+func TestCompensationWritesRequirePermission(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		emp := f.insert(t, f.nuevoEmpleado("ana@empresa.com", f.ventas, nil))
+		f.grant(t, "hr_analyst", "INSERT")
+		f.grant(t, "hr_analyst", "UPDATE")
+		analyst := newTestSession("hr_analyst", shared.AlcanceTodos, nil)
+		// El alta y UPDATE siempre fijan el sueldo.
+		expectError(t, f.call(t, analyst, "INSERT", f.nuevoEmpleado("luis@empresa.com", f.ventas, nil)),
+			shared.CodeForbidden, shared.PermisoVerCompensacion)
+		expectError(t, f.call(t, analyst, "UPDATE", shared.UpdateEmpleadoDTO{ID: emp.ID, PrimerNombre: "Ana",
+			Email: "ana@empresa.com", FechaNac: "1990-05-15", Sueldo: 3000, CargoID: f.analista, DptoID: f.ventas,
+			Version: emp.Version}), shared.CodeForbidden, shared.PermisoVerCompensacion)
+	})
+}
+
+func TestSensitiveFieldsAreMasked(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		emp := f.insert(t, f.nuevoEmpleado("ana@empresa.com", f.ventas, nil))
+		analyst := newTestSession("hr_analyst", shared.AlcanceTodos, nil)
+		var detail map[string]any
+		f.mustCall(t, analyst, "SELECT", map[string]any{"empl_id": emp.ID}, &detail)
+		for _, campo := range []string{"sueldo", "comision", "email", "fecha_nac"} {
+			if detail[campo] != nil {
+				t.Errorf("%s visible para hr_analyst: %v", campo, detail[campo])
+			}
+		}
+		if detail["primer_nombre"] != "Ana" {
+			t.Errorf("se enmascararon campos no sensibles: %v", detail)
+		}
+	})
+}
+
+func TestSubscribeRequiresSocket(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, f *testFixture) {
+		manager := newTestSession("hr_manager", shared.AlcanceTodos, nil)
+		expectError(t, f.call(t, manager, "SUBSCRIBE", nil), "", "solo está disponible en conexiones de socket")
+	})
+}
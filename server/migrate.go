@@ -0,0 +1,502 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Las migraciones van embebidas en el binario como
+// migrations/<dialecto>/NNNN_nombre.up.sql y su reverso NNNN_nombre.down.sql.
+// Cada dialecto tiene su propia secuencia de versiones.
+//
+//go:embed migrations/postgres/*.sql migrations/sqlite/*.sql
+var migrationFiles embed.FS
+
+// migrationLockID identifica el advisory lock que serializa las migraciones
+// entre procesos en Postgres.
+const migrationLockID = 72_410_041
+
+var errLegacySchema = errors.New("la base de datos no tiene schema_migrations; registre el esquema existente con 'migrate baseline <versión>'")
+
+var migrationName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// migrationStatus es el estado de una versión: aplicada, pendiente,
+// modificada (el checksum no coincide con el aplicado) o desconocida (está en
+// la base de datos pero no en el binario).
+type migrationStatus struct {
+	Version  int
+	Name     string
+	Estado   string
+	Aplicada *time.Time
+}
+
+type appliedMigration struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+func loadMigrations(fsys fs.FS, dir string) ([]migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo migraciones: %v", err)
+	}
+	byVersion := make(map[int]*migration)
+	for _, entry := range entries {
+		m := migrationName.FindStringSubmatch(entry.Name())
+		if m == nil {
+			return nil, fmt.Errorf("nombre de migración inválido: %s", entry.Name())
+		}
+		version, _ := strconv.Atoi(m[1])
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error leyendo %s: %v", entry.Name(), err)
+		}
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		} else if mig.Name != m[2] {
+			return nil, fmt.Errorf("la versión %d tiene dos nombres: %s y %s", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(content)
+			sum := sha256.Sum256(content)
+			mig.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			mig.Down = string(content)
+		}
+	}
+	migrations := make([]migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("la migración %04d_%s necesita archivos up y down", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    dialect
+	migrations []migration
+}
+
+func NewMigrator(db *sql.DB, d dialect) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationFiles, path.Join("migrations", string(d)))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: d, migrations: migrations}, nil
+}
+
+// withLock ejecuta fn en una conexión dedicada que tiene el advisory lock de
+// migraciones, así dos servidores que arrancan a la vez no migran en paralelo.
+// SQLite no tiene advisory locks; cada migración corre en una transacción
+// BEGIN IMMEDIATE, que ya excluye a los demás escritores.
+func (m *Migrator) withLock(fn func(ctx context.Context, conn *sql.Conn) error) error {
+	ctx := context.Background()
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error obteniendo conexión: %v", err)
+	}
+	defer conn.Close()
+	if m.dialect == dialectSQLite {
+		return fn(ctx, conn)
+	}
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("error bloqueando migraciones: %v", err)
+	}
+	defer conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, migrationLockID)
+	return fn(ctx, conn)
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// applied devuelve las migraciones registradas, o nil si schema_migrations
+// aún no existe.
+func (m *Migrator) applied(ctx context.Context, q queryer) (map[int]appliedMigration, error) {
+	var exists bool
+	err := q.QueryRowContext(ctx, m.dialect.tableExistsQuery(), "schema_migrations").Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("error consultando schema_migrations: %v", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	rows, err := q.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("error consultando schema_migrations: %v", err)
+	}
+	defer rows.Close()
+	applied := make(map[int]appliedMigration)
+	for rows.Next() {
+		var version int
+		var a appliedMigration
+		if err := rows.Scan(&version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, fmt.Errorf("error leyendo schema_migrations: %v", err)
+		}
+		applied[version] = a
+	}
+	return applied, rows.Err()
+}
+
+// legacySchema indica una base de datos creada con los scripts de
+// docker-entrypoint-initdb.d, que tiene tablas pero no schema_migrations.
+func (m *Migrator) legacySchema(ctx context.Context, q queryer) (bool, error) {
+	var exists bool
+	err := q.QueryRowContext(ctx, m.dialect.tableExistsQuery(), "empleados").Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("error consultando el esquema: %v", err)
+	}
+	return exists, nil
+}
+
+func (m *Migrator) verify(applied map[int]appliedMigration) error {
+	known := make(map[int]bool, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		if a, ok := applied[mig.Version]; ok && a.checksum != mig.Checksum {
+			return fmt.Errorf("la migración %04d_%s cambió después de aplicarse (checksum distinto)", mig.Version, mig.Name)
+		}
+	}
+	for version, a := range applied {
+		if !known[version] {
+			return fmt.Errorf("la base de datos tiene la migración %04d_%s, que este servidor no conoce", version, a.name)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) Status() ([]migrationStatus, error) {
+	ctx := context.Background()
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[int]bool, len(m.migrations))
+	var result []migrationStatus
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		st := migrationStatus{Version: mig.Version, Name: mig.Name, Estado: "pendiente"}
+		if a, ok := applied[mig.Version]; ok {
+			at := a.appliedAt
+			st.Aplicada = &at
+			st.Estado = "aplicada"
+			if a.checksum != mig.Checksum {
+				st.Estado = "modificada"
+			}
+		}
+		result = append(result, st)
+	}
+	for version, a := range applied {
+		if !known[version] {
+			at := a.appliedAt
+			result = append(result, migrationStatus{Version: version, Name: a.name, Estado: "desconocida", Aplicada: &at})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// Check falla si el esquema no corresponde exactamente a las migraciones del
+// binario.
+func (m *Migrator) Check() error {
+	ctx := context.Background()
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return err
+	}
+	if applied == nil {
+		legacy, err := m.legacySchema(ctx, m.db)
+		if err != nil {
+			return err
+		}
+		if legacy {
+			return errLegacySchema
+		}
+	}
+	if err := m.verify(applied); err != nil {
+		return err
+	}
+	pending := 0
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; !ok {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("el esquema está desactualizado: %d migraciones pendientes; ejecute 'migrate up' o inicie con HR_AUTO_MIGRATE=true", pending)
+	}
+	return nil
+}
+
+// SchemaVersion devuelve la mayor versión aplicada y cuántas migraciones
+// del binario faltan por aplicar.
+func (m *Migrator) SchemaVersion(ctx context.Context) (version, pending int, err error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return 0, 0, err
+	}
+	for v := range applied {
+		version = max(version, v)
+	}
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; !ok {
+			pending++
+		}
+	}
+	return version, pending, nil
+}
+
+// Up aplica en orden las migraciones pendientes, cada una en su transacción.
+func (m *Migrator) Up() (int, error) {
+	count := 0
+	err := m.withLock(func(ctx context.Context, conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if applied == nil {
+			legacy, err := m.legacySchema(ctx, conn)
+			if err != nil {
+				return err
+			}
+			if legacy {
+				return errLegacySchema
+			}
+			if err := createMigrationsTable(ctx, conn, m.dialect); err != nil {
+				return err
+			}
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+					mig.Version, mig.Name, mig.Checksum)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("error aplicando migración %04d_%s: %v", mig.Version, mig.Name, err)
+			}
+			log.Printf("✓ Migración %04d_%s aplicada", mig.Version, mig.Name)
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Down revierte las últimas n migraciones aplicadas.
+func (m *Migrator) Down(n int) (int, error) {
+	count := 0
+	err := m.withLock(func(ctx context.Context, conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(applied); err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && count < n; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, mig.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("error revirtiendo migración %04d_%s: %v", mig.Version, mig.Name, err)
+			}
+			log.Printf("✓ Migración %04d_%s revertida", mig.Version, mig.Name)
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Baseline registra como aplicadas, sin ejecutarlas, las migraciones hasta
+// version. Sirve para adoptar una base de datos creada con los antiguos
+// scripts de inicialización.
+func (m *Migrator) Baseline(version int) error {
+	return m.withLock(func(ctx context.Context, conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(applied) > 0 {
+			return fmt.Errorf("schema_migrations ya tiene migraciones registradas")
+		}
+		found := false
+		for _, mig := range m.migrations {
+			if mig.Version == version {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("la versión %d no existe", version)
+		}
+		return inTx(ctx, conn, func(tx *sql.Tx) error {
+			if applied == nil {
+				if err := createMigrationsTable(ctx, tx, m.dialect); err != nil {
+					return err
+				}
+			}
+			for _, mig := range m.migrations {
+				if mig.Version > version {
+					break
+				}
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+					mig.Version, mig.Name, mig.Checksum)
+				if err != nil {
+					return fmt.Errorf("error registrando migración %04d_%s: %v", mig.Version, mig.Name, err)
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func createMigrationsTable(ctx context.Context, db execer, d dialect) error {
+	appliedAt := "TIMESTAMPTZ NOT NULL DEFAULT NOW()"
+	if d == dialectSQLite {
+		appliedAt = "TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP"
+	}
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name VARCHAR(100) NOT NULL,
+		checksum CHAR(64) NOT NULL,
+		applied_at `+appliedAt+`
+	)`)
+	if err != nil {
+		return fmt.Errorf("error creando schema_migrations: %v", err)
+	}
+	return nil
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// runMigrate implementa el subcomando "migrate up|down [n]|status|baseline <versión>".
+func runMigrate(args []string) error {
+	db, cfg, err := openDB(context.Background())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	migrator, err := NewMigrator(db, cfg.dialect)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("uso: migrate up | down [n] | status | baseline <versión>")
+	}
+	switch args[0] {
+	case "up":
+		count, err := migrator.Up()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migraciones aplicadas\n", count)
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				return fmt.Errorf("cantidad inválida: %s", args[1])
+			}
+		}
+		count, err := migrator.Down(n)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migraciones revertidas\n", count)
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSIÓN\tNOMBRE\tESTADO\tAPLICADA")
+		for _, st := range statuses {
+			aplicada := "-"
+			if st.Aplicada != nil {
+				aplicada = st.Aplicada.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", st.Version, st.Name, st.Estado, aplicada)
+		}
+		return w.Flush()
+	case "baseline":
+		if len(args) < 2 {
+			return fmt.Errorf("uso: migrate baseline <versión>")
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("versión inválida: %s", args[1])
+		}
+		if err := migrator.Baseline(version); err != nil {
+			return err
+		}
+		fmt.Printf("Esquema registrado hasta la versión %04d\n", version)
+	default:
+		return fmt.Errorf("subcomando de migrate desconocido: %s", args[0])
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"path"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedMigrationsAreSequential(t *testing.T) {
+	for _, d := range []dialect{dialectPostgres, dialectSQLite} {
+		migrations, err := loadMigrations(migrationFiles, path.Join("migrations", string(d)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(migrations) == 0 {
+			t.Fatalf("no hay migraciones embebidas para %s", d)
+		}
+		for i, mig := range migrations {
+			if mig.Version != i+1 {
+				t.Fatalf("%s: se esperaba la versión %d, se encontró %04d_%s", d, i+1, mig.Version, mig.Name)
+			}
+			if len(mig.Checksum) != 64 {
+				t.Errorf("%s: checksum inválido para %04d_%s: %q", d, mig.Version, mig.Name, mig.Checksum)
+			}
+		}
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/0002_b.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+		"m/0002_b.down.sql": {Data: []byte("DROP TABLE b;")},
+		"m/0001_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+		"m/0001_a.down.sql": {Data: []byte("DROP TABLE a;")},
+	}
+	migrations, err := loadMigrations(fsys, "m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 || migrations[0].Name != "a" || migrations[1].Name != "b" {
+		t.Fatalf("orden inesperado: %+v", migrations)
+	}
+	if migrations[0].Checksum == migrations[1].Checksum {
+		t.Error("migraciones distintas con el mismo checksum")
+	}
+
+	cases := map[string]fstest.MapFS{
+		"sin down": {
+			"m/0001_a.up.sql": {Data: []byte("SELECT 1;")},
+		},
+		"nombre inválido": {
+			"m/a.sql": {Data: []byte("SELECT 1;")},
+		},
+		"nombres distintos": {
+			"m/0001_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"m/0001_b.down.sql": {Data: []byte("SELECT 1;")},
+		},
+	}
+	for name, fsys := range cases {
+		if _, err := loadMigrations(fsys, "m"); err == nil {
+			t.Errorf("%s: se esperaba error", name)
+		}
+	}
+}
+
+func TestVerifyDetectsChangedAndUnknownMigrations(t *testing.T) {
+	m := &Migrator{migrations: []migration{{Version: 1, Name: "a", Checksum: "x"}}}
+	if err := m.verify(map[int]appliedMigration{1: {name: "a", checksum: "x"}}); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	err := m.verify(map[int]appliedMigration{1: {name: "a", checksum: "y"}})
+	if err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("se esperaba error de checksum, se obtuvo %v", err)
+	}
+	if err := m.verify(map[int]appliedMigration{2: {name: "b"}}); err == nil {
+		t.Error("se esperaba error por migración desconocida")
+	}
+}
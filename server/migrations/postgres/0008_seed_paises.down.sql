@@ -0,0 +1 @@
+TRUNCATE paises RESTART IDENTITY CASCADE;
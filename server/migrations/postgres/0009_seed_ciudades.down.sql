@@ -0,0 +1 @@
+TRUNCATE ciudades RESTART IDENTITY CASCADE;
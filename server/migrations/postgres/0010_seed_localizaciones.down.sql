@@ -0,0 +1 @@
+TRUNCATE localizaciones RESTART IDENTITY CASCADE;
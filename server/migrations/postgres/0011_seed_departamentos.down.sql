@@ -0,0 +1 @@
+TRUNCATE departamentos RESTART IDENTITY CASCADE;
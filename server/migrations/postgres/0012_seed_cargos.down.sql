@@ -0,0 +1 @@
+TRUNCATE cargos RESTART IDENTITY CASCADE;
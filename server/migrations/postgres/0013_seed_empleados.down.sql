@@ -0,0 +1 @@
+TRUNCATE empleados RESTART IDENTITY CASCADE;
@@ -0,0 +1,16 @@
+DROP INDEX idx_ciudades_pais;
+DROP INDEX idx_localizaciones_ciudad;
+DROP INDEX idx_departamentos_localiz;
+DROP INDEX idx_empleados_cargo;
+DROP INDEX idx_empleados_gerente;
+DROP INDEX idx_empleados_dpto;
+DROP INDEX idx_historico_cargo;
+DROP INDEX idx_historico_dpto;
+DROP INDEX idx_empleados_email;
+DROP INDEX idx_empleados_nombre;
+DROP INDEX idx_departamentos_nombre;
+DROP INDEX idx_cargos_nombre;
+DROP INDEX idx_paises_nombre;
+DROP INDEX idx_ciudades_nombre;
+DROP INDEX idx_empleados_dpto_cargo;
+DROP INDEX idx_historico_fecha_cargo;
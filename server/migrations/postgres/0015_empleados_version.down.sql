@@ -0,0 +1 @@
+ALTER TABLE empleados DROP COLUMN empl_version;
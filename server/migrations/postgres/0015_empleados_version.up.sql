@@ -0,0 +1 @@
+ALTER TABLE empleados ADD COLUMN empl_version INTEGER NOT NULL DEFAULT 1;
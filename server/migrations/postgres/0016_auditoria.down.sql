@@ -0,0 +1,2 @@
+DROP TABLE auditoria;
+DROP FUNCTION f_auditoria_solo_insercion();
@@ -0,0 +1,27 @@
+CREATE TABLE auditoria (
+    audit_ID BIGSERIAL PRIMARY KEY,
+    audit_fecha TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    audit_operador VARCHAR(100) NOT NULL,
+    audit_cliente VARCHAR(100) NOT NULL,
+    audit_operacion VARCHAR(30) NOT NULL,
+    audit_empl_ID INTEGER,
+    audit_antes JSONB,
+    audit_despues JSONB
+);
+
+CREATE INDEX idx_auditoria_empleado ON auditoria(audit_empl_ID);
+CREATE INDEX idx_auditoria_operador ON auditoria(audit_operador);
+CREATE INDEX idx_auditoria_fecha ON auditoria(audit_fecha);
+
+CREATE OR REPLACE FUNCTION f_auditoria_solo_insercion()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    RAISE EXCEPTION 'La auditoría es de solo inserción';
+END;
+$$;
+
+CREATE TRIGGER tr_auditoria_solo_insercion
+BEFORE UPDATE OR DELETE OR TRUNCATE ON auditoria
+FOR EACH STATEMENT EXECUTE FUNCTION f_auditoria_solo_insercion();
@@ -0,0 +1,8 @@
+CREATE TABLE usuarios (
+    usr_ID SERIAL PRIMARY KEY,
+    usr_nombre VARCHAR(50) NOT NULL UNIQUE,
+    usr_password_hash VARCHAR(100) NOT NULL,
+    usr_rol VARCHAR(30) NOT NULL DEFAULT 'usuario',
+    usr_activo BOOLEAN NOT NULL DEFAULT TRUE,
+    usr_creado TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
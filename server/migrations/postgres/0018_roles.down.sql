@@ -0,0 +1,4 @@
+ALTER TABLE usuarios DROP CONSTRAINT fk_usuarios_rol;
+ALTER TABLE usuarios ALTER COLUMN usr_rol SET DEFAULT 'usuario';
+DROP TABLE rol_permisos;
+DROP TABLE roles;
@@ -0,0 +1,50 @@
+CREATE TABLE roles (
+    rol_nombre VARCHAR(30) PRIMARY KEY,
+    rol_descripcion VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE rol_permisos (
+    rolperm_rol VARCHAR(30) NOT NULL,
+    rolperm_permiso VARCHAR(50) NOT NULL,
+    PRIMARY KEY (rolperm_rol, rolperm_permiso),
+    FOREIGN KEY (rolperm_rol) REFERENCES roles(rol_nombre) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+INSERT INTO roles (rol_nombre, rol_descripcion) VALUES
+('admin', 'Acceso total, incluida la gestión de usuarios y roles'),
+('hr_manager', 'Gestión completa de empleados y consulta de auditoría'),
+('hr_analyst', 'Consulta de empleados, catálogos y auditoría'),
+('department_manager', 'Consulta y edición parcial de empleados'),
+('self_service', 'Consulta de datos propios y catálogos');
+
+INSERT INTO rol_permisos (rolperm_rol, rolperm_permiso) VALUES
+('admin', '*'),
+('hr_manager', 'INSERT'),
+('hr_manager', 'UPDATE'),
+('hr_manager', 'PATCH'),
+('hr_manager', 'SELECT'),
+('hr_manager', 'DELETE'),
+('hr_manager', 'RESTORE'),
+('hr_manager', 'BATCH'),
+('hr_manager', 'LIST_AUDIT'),
+('hr_manager', 'LIST_CARGOS'),
+('hr_manager', 'LIST_DEPARTAMENTOS_CON_DATOS'),
+('hr_manager', 'LIST_GERENTES'),
+('hr_analyst', 'SELECT'),
+('hr_analyst', 'LIST_AUDIT'),
+('hr_analyst', 'LIST_CARGOS'),
+('hr_analyst', 'LIST_DEPARTAMENTOS_CON_DATOS'),
+('hr_analyst', 'LIST_GERENTES'),
+('department_manager', 'SELECT'),
+('department_manager', 'PATCH'),
+('department_manager', 'LIST_CARGOS'),
+('department_manager', 'LIST_DEPARTAMENTOS_CON_DATOS'),
+('department_manager', 'LIST_GERENTES'),
+('self_service', 'SELECT'),
+('self_service', 'LIST_CARGOS'),
+('self_service', 'LIST_DEPARTAMENTOS_CON_DATOS');
+
+UPDATE usuarios SET usr_rol = 'self_service' WHERE usr_rol = 'usuario';
+ALTER TABLE usuarios ALTER COLUMN usr_rol SET DEFAULT 'self_service';
+ALTER TABLE usuarios ADD CONSTRAINT fk_usuarios_rol
+    FOREIGN KEY (usr_rol) REFERENCES roles(rol_nombre) ON DELETE RESTRICT ON UPDATE CASCADE;
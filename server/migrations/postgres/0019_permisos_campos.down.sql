@@ -0,0 +1,2 @@
+DELETE FROM rol_permisos
+WHERE rolperm_permiso IN ('VER_COMPENSACION', 'VER_DATOS_PERSONALES');
@@ -0,0 +1,3 @@
+INSERT INTO rol_permisos (rolperm_rol, rolperm_permiso) VALUES
+('hr_manager', 'VER_COMPENSACION'),
+('hr_manager', 'VER_DATOS_PERSONALES');
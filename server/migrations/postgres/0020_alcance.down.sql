@@ -0,0 +1,3 @@
+DROP FUNCTION f_empleados_a_cargo(INTEGER);
+ALTER TABLE roles DROP COLUMN rol_alcance;
+ALTER TABLE usuarios DROP COLUMN usr_empl_ID;
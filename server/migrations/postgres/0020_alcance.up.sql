@@ -0,0 +1,30 @@
+ALTER TABLE usuarios ADD COLUMN usr_empl_ID INTEGER;
+ALTER TABLE usuarios ADD CONSTRAINT fk_usuarios_empleado
+    FOREIGN KEY (usr_empl_ID) REFERENCES empleados(empl_ID) ON DELETE SET NULL;
+
+ALTER TABLE roles ADD COLUMN rol_alcance VARCHAR(20) NOT NULL DEFAULT 'todos'
+    CHECK (rol_alcance IN ('todos', 'departamento', 'propio'));
+UPDATE roles SET rol_alcance = 'departamento' WHERE rol_nombre = 'department_manager';
+UPDATE roles SET rol_alcance = 'propio' WHERE rol_nombre = 'self_service';
+
+-- Empleados visibles para un jefe: su departamento, toda su línea de reporte
+-- (según empl_Gerente_ID) y él mismo.
+CREATE OR REPLACE FUNCTION f_empleados_a_cargo(p_empl_id INTEGER)
+RETURNS SETOF INTEGER
+LANGUAGE sql
+STABLE
+AS $$
+    WITH RECURSIVE subordinados AS (
+        SELECT empl_ID FROM empleados WHERE empl_Gerente_ID = p_empl_id
+        UNION
+        SELECT e.empl_ID
+        FROM empleados e
+        INNER JOIN subordinados s ON e.empl_Gerente_ID = s.empl_ID
+    )
+    SELECT empl_ID FROM empleados
+    WHERE empl_dpto_ID = (SELECT empl_dpto_ID FROM empleados WHERE empl_ID = p_empl_id)
+    UNION
+    SELECT empl_ID FROM subordinados
+    UNION
+    SELECT p_empl_id;
+$$;
@@ -0,0 +1,6 @@
+DELETE FROM rol_permisos WHERE rolperm_permiso = 'SUBSCRIBE';
+DROP TRIGGER tr_departamentos_evento ON departamentos;
+DROP TRIGGER tr_cargos_evento ON cargos;
+DROP TRIGGER tr_auditoria_evento ON auditoria;
+DROP FUNCTION f_notificar_evento_catalogo();
+DROP FUNCTION f_notificar_evento_empleado();
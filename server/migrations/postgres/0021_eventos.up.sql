@@ -0,0 +1,68 @@
+-- Eventos de cambios para SUBSCRIBE. Los de empleados salen de la auditoría,
+-- así que solo se publican cambios confirmados y ya auditados. NOTIFY limita
+-- el mensaje a 8000 bytes: el evento lleva identificadores, no filas.
+
+CREATE OR REPLACE FUNCTION f_notificar_evento_empleado()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    PERFORM pg_notify('hr_eventos', json_build_object(
+        'tipo', 'empleado.' || CASE NEW.audit_operacion
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'DELETE' THEN 'deleted'
+            WHEN 'RESTORE' THEN 'restored'
+            ELSE 'updated'
+        END,
+        'id', NEW.audit_empl_ID,
+        'dpto_id', COALESCE(NEW.audit_despues->>'empl_dpto_id', NEW.audit_antes->>'empl_dpto_id')::INTEGER,
+        'dpto_anterior_id', (NEW.audit_antes->>'empl_dpto_id')::INTEGER,
+        'audit_id', NEW.audit_ID,
+        'operador', NEW.audit_operador,
+        'fecha', NEW.audit_fecha
+    )::TEXT);
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER tr_auditoria_evento
+AFTER INSERT ON auditoria
+FOR EACH ROW EXECUTE FUNCTION f_notificar_evento_empleado();
+
+-- Cargos y departamentos no se modifican por el protocolo; se notifican los
+-- cambios hechos directamente en la base de datos.
+CREATE OR REPLACE FUNCTION f_notificar_evento_catalogo()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+DECLARE
+    v_fila JSONB;
+BEGIN
+    v_fila := to_jsonb(COALESCE(NEW, OLD));
+    PERFORM pg_notify('hr_eventos', json_build_object(
+        'tipo', TG_ARGV[0] || '.' || CASE TG_OP
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'DELETE' THEN 'deleted'
+            ELSE 'updated'
+        END,
+        'id', (v_fila->>TG_ARGV[1])::INTEGER,
+        'dpto_id', CASE WHEN TG_ARGV[0] = 'departamento' THEN (v_fila->>'dpto_id')::INTEGER END,
+        'operador', current_user,
+        'fecha', NOW()
+    )::TEXT);
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER tr_cargos_evento
+AFTER INSERT OR UPDATE OR DELETE ON cargos
+FOR EACH ROW EXECUTE FUNCTION f_notificar_evento_catalogo('cargo', 'cargo_id');
+
+CREATE TRIGGER tr_departamentos_evento
+AFTER INSERT OR UPDATE OR DELETE ON departamentos
+FOR EACH ROW EXECUTE FUNCTION f_notificar_evento_catalogo('departamento', 'dpto_id');
+
+INSERT INTO rol_permisos (rolperm_rol, rolperm_permiso) VALUES
+('hr_manager', 'SUBSCRIBE'),
+('hr_analyst', 'SUBSCRIBE'),
+('department_manager', 'SUBSCRIBE');
@@ -0,0 +1,103 @@
+-- Webhooks salientes. Los eventos de 0021_eventos.up.sql se encolan en
+-- webhook_outbox dentro de la misma transacción que el cambio (outbox
+-- transaccional); el servidor los entrega y reintenta. Las entregas que
+-- agotan los reintentos quedan en estado 'fallido' (dead letter).
+
+CREATE TABLE webhooks (
+    wh_ID SERIAL PRIMARY KEY,
+    wh_url VARCHAR(500) NOT NULL,
+    wh_tipos TEXT[] NOT NULL DEFAULT '{}',
+    wh_secreto VARCHAR(200) NOT NULL,
+    wh_activo BOOLEAN NOT NULL DEFAULT TRUE,
+    wh_creado TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE webhook_outbox (
+    out_ID BIGSERIAL PRIMARY KEY,
+    out_wh_ID INTEGER NOT NULL REFERENCES webhooks(wh_ID) ON DELETE CASCADE,
+    out_tipo VARCHAR(50) NOT NULL,
+    out_payload JSONB NOT NULL,
+    out_estado VARCHAR(20) NOT NULL DEFAULT 'pendiente'
+        CHECK (out_estado IN ('pendiente', 'entregado', 'fallido')),
+    out_intentos INTEGER NOT NULL DEFAULT 0,
+    out_proximo TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    out_ultimo_error TEXT,
+    out_creado TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    out_entregado TIMESTAMPTZ
+);
+
+CREATE INDEX idx_webhook_outbox_pendientes ON webhook_outbox(out_proximo) WHERE out_estado = 'pendiente';
+CREATE INDEX idx_webhook_outbox_fallidos ON webhook_outbox(out_ID) WHERE out_estado = 'fallido';
+
+-- wh_tipos vacío recibe todos los eventos; si no, acepta tipos exactos
+-- ('empleado.created') o entidades ('empleado', 'empleado.*').
+CREATE OR REPLACE FUNCTION f_encolar_webhooks(p_tipo TEXT, p_payload JSONB)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+BEGIN
+    INSERT INTO webhook_outbox (out_wh_ID, out_tipo, out_payload)
+    SELECT wh_ID, p_tipo, p_payload
+    FROM webhooks
+    WHERE wh_activo
+      AND (cardinality(wh_tipos) = 0
+           OR p_tipo = ANY(wh_tipos)
+           OR split_part(p_tipo, '.', 1) = ANY(wh_tipos)
+           OR split_part(p_tipo, '.', 1) || '.*' = ANY(wh_tipos));
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION f_notificar_evento_empleado()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+DECLARE
+    v_evento JSONB;
+BEGIN
+    v_evento := jsonb_build_object(
+        'tipo', 'empleado.' || CASE NEW.audit_operacion
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'DELETE' THEN 'deleted'
+            WHEN 'RESTORE' THEN 'restored'
+            ELSE 'updated'
+        END,
+        'id', NEW.audit_empl_ID,
+        'dpto_id', COALESCE(NEW.audit_despues->>'empl_dpto_id', NEW.audit_antes->>'empl_dpto_id')::INTEGER,
+        'dpto_anterior_id', (NEW.audit_antes->>'empl_dpto_id')::INTEGER,
+        'audit_id', NEW.audit_ID,
+        'operador', NEW.audit_operador,
+        'fecha', NEW.audit_fecha
+    );
+    PERFORM pg_notify('hr_eventos', v_evento::TEXT);
+    -- Los webhooks reciben además la fila del empleado.
+    PERFORM f_encolar_webhooks(v_evento->>'tipo',
+        v_evento || jsonb_build_object('empleado', COALESCE(NEW.audit_despues, NEW.audit_antes)));
+    RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION f_notificar_evento_catalogo()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+DECLARE
+    v_fila JSONB;
+    v_evento JSONB;
+BEGIN
+    v_fila := to_jsonb(COALESCE(NEW, OLD));
+    v_evento := jsonb_build_object(
+        'tipo', TG_ARGV[0] || '.' || CASE TG_OP
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'DELETE' THEN 'deleted'
+            ELSE 'updated'
+        END,
+        'id', (v_fila->>TG_ARGV[1])::INTEGER,
+        'dpto_id', CASE WHEN TG_ARGV[0] = 'departamento' THEN (v_fila->>'dpto_id')::INTEGER END,
+        'operador', current_user,
+        'fecha', NOW()
+    );
+    PERFORM pg_notify('hr_eventos', v_evento::TEXT);
+    PERFORM f_encolar_webhooks(v_evento->>'tipo', v_evento || jsonb_build_object(TG_ARGV[0], v_fila));
+    RETURN NULL;
+END;
+$$;
@@ -0,0 +1 @@
+DROP FUNCTION p_delete_empleado(INTEGER);
@@ -41,7 +41,7 @@ BEGIN
     VALUES (v_empleado_record.empl_cargo_id, v_empleado_record.empl_dpto_id);
 
     UPDATE empleados
-    SET is_deleted = true
+    SET is_deleted = true, empl_version = empleados.empl_version + 1
     WHERE empleados.empl_id = p_empl_id;
 
     RETURN QUERY SELECT
@@ -0,0 +1,7 @@
+DROP TABLE historico;
+DROP TABLE empleados;
+DROP TABLE cargos;
+DROP TABLE departamentos;
+DROP TABLE localizaciones;
+DROP TABLE ciudades;
+DROP TABLE paises;
@@ -0,0 +1,81 @@
+-- Esquema de SQLite equivalente a las migraciones 0001 a 0007, 0014 y 0015 de
+-- Postgres. Las claves enteras usan INTEGER PRIMARY KEY (alias de rowid) en
+-- lugar de SERIAL; las claves foráneas requieren PRAGMA foreign_keys, que el
+-- servidor activa en cada conexión.
+
+CREATE TABLE paises (
+    pais_ID INTEGER PRIMARY KEY,
+    pais_nombre VARCHAR(100) NOT NULL UNIQUE
+);
+
+CREATE TABLE ciudades (
+    ciud_ID INTEGER PRIMARY KEY,
+    ciud_pais_ID INTEGER NOT NULL,
+    ciud_nombre VARCHAR(100) NOT NULL,
+    FOREIGN KEY (ciud_pais_ID) REFERENCES paises(pais_ID) ON DELETE CASCADE
+);
+
+CREATE TABLE localizaciones (
+    localiz_ID INTEGER PRIMARY KEY,
+    localiz_ciudad_ID INTEGER NOT NULL,
+    localiz_direccion VARCHAR(255) NOT NULL,
+    FOREIGN KEY (localiz_ciudad_ID) REFERENCES ciudades(ciud_ID) ON DELETE CASCADE
+);
+
+CREATE TABLE departamentos (
+    dpto_ID INTEGER PRIMARY KEY,
+    dpto_localiz_ID INTEGER NOT NULL,
+    dpto_nombre VARCHAR(100) NOT NULL,
+    FOREIGN KEY (dpto_localiz_ID) REFERENCES localizaciones(localiz_ID) ON DELETE CASCADE
+);
+
+CREATE TABLE cargos (
+    cargo_ID INTEGER PRIMARY KEY,
+    cargo_nombre VARCHAR(100) NOT NULL UNIQUE,
+    cargo_sueldo_minimo DECIMAL(10,2) NOT NULL,
+    cargo_sueldo_maximo DECIMAL(10,2) NOT NULL,
+    CHECK (cargo_sueldo_maximo >= cargo_sueldo_minimo)
+);
+
+CREATE TABLE empleados (
+    empl_ID INTEGER PRIMARY KEY,
+    empl_primer_nombre VARCHAR(50) NOT NULL,
+    empl_segundo_nombre VARCHAR(50),
+    empl_email VARCHAR(100) NOT NULL UNIQUE,
+    empl_fecha_nac DATE NOT NULL,
+    empl_sueldo DECIMAL(10,2) NOT NULL,
+    empl_comision DECIMAL(5,2) DEFAULT 0.00,
+    empl_cargo_ID INTEGER NOT NULL,
+    empl_Gerente_ID INTEGER,
+    empl_dpto_ID INTEGER NOT NULL,
+    is_deleted BOOLEAN DEFAULT FALSE,
+    empl_version INTEGER NOT NULL DEFAULT 1,
+    FOREIGN KEY (empl_cargo_ID) REFERENCES cargos(cargo_ID) ON DELETE RESTRICT,
+    FOREIGN KEY (empl_Gerente_ID) REFERENCES empleados(empl_ID) ON DELETE SET NULL,
+    FOREIGN KEY (empl_dpto_ID) REFERENCES departamentos(dpto_ID) ON DELETE RESTRICT
+);
+
+CREATE TABLE historico (
+    emphist_ID INTEGER PRIMARY KEY,
+    emphist_fecha_retiro DATE NOT NULL DEFAULT CURRENT_DATE,
+    emphist_cargo_ID INTEGER NOT NULL,
+    emphist_dpto_ID INTEGER NOT NULL,
+    FOREIGN KEY (emphist_cargo_ID) REFERENCES cargos(cargo_ID) ON DELETE CASCADE,
+    FOREIGN KEY (emphist_dpto_ID) REFERENCES departamentos(dpto_ID) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_ciudades_pais ON ciudades(ciud_pais_ID);
+CREATE INDEX idx_localizaciones_ciudad ON localizaciones(localiz_ciudad_ID);
+CREATE INDEX idx_departamentos_localiz ON departamentos(dpto_localiz_ID);
+CREATE INDEX idx_empleados_cargo ON empleados(empl_cargo_ID);
+CREATE INDEX idx_empleados_gerente ON empleados(empl_Gerente_ID);
+CREATE INDEX idx_empleados_dpto ON empleados(empl_dpto_ID);
+CREATE INDEX idx_historico_cargo ON historico(emphist_cargo_ID);
+CREATE INDEX idx_historico_dpto ON historico(emphist_dpto_ID);
+
+CREATE INDEX idx_empleados_nombre ON empleados(empl_primer_nombre);
+CREATE INDEX idx_departamentos_nombre ON departamentos(dpto_nombre);
+CREATE INDEX idx_ciudades_nombre ON ciudades(ciud_nombre);
+
+CREATE INDEX idx_empleados_dpto_cargo ON empleados(empl_dpto_ID, empl_cargo_ID);
+CREATE INDEX idx_historico_fecha_cargo ON historico(emphist_fecha_retiro, emphist_cargo_ID);
@@ -0,0 +1,7 @@
+DELETE FROM historico;
+DELETE FROM empleados;
+DELETE FROM cargos;
+DELETE FROM departamentos;
+DELETE FROM localizaciones;
+DELETE FROM ciudades;
+DELETE FROM paises;
@@ -0,0 +1,43 @@
+-- Datos iniciales, los mismos de las migraciones 0008 a 0013 de Postgres.
+
+INSERT INTO paises (pais_nombre) VALUES
+('Colombia'),
+('México'),
+('Argentina'),
+('Chile'),
+('Perú');
+
+INSERT INTO ciudades (ciud_pais_ID, ciud_nombre) VALUES
+(1, 'Bogotá'),
+(1, 'Medellín'),
+(2, 'Ciudad de México'),
+(3, 'Buenos Aires'),
+(4, 'Santiago');
+
+INSERT INTO localizaciones (localiz_ciudad_ID, localiz_direccion) VALUES
+(1, 'Carrera 7 #32-16, Bogotá'),
+(1, 'Calle 72 #10-34, Bogotá'),
+(2, 'Carrera 43A #1-50, Medellín'),
+(3, 'Av. Reforma 222, CDMX'),
+(4, 'Av. Corrientes 1234, Buenos Aires');
+
+INSERT INTO departamentos (dpto_localiz_ID, dpto_nombre) VALUES
+(1, 'Recursos Humanos'),
+(2, 'Tecnología'),
+(3, 'Ventas'),
+(4, 'Marketing'),
+(5, 'Finanzas');
+
+INSERT INTO cargos (cargo_nombre, cargo_sueldo_minimo, cargo_sueldo_maximo) VALUES
+('Asistente Administrativo', 1800000, 2500000),
+('Contador', 3000000, 4500000),
+('Gerente de Ventas', 4000000, 6000000),
+('Especialista en Marketing', 2800000, 4000000),
+('Director General', 7000000, 10000000);
+
+INSERT INTO empleados (empl_primer_nombre, empl_segundo_nombre, empl_email, empl_fecha_nac, empl_sueldo, empl_comision, empl_cargo_ID, empl_Gerente_ID, empl_dpto_ID) VALUES
+('Carlos', 'Alberto', 'carlos.alberto@empresa.com', '1975-03-15', 8500000, 0.00, 5, NULL, 1),
+('María', 'Elena', 'maria.elena@empresa.com', '1985-07-22', 5500000, 0.00, 3, 1, 3),
+('José', 'Manuel', 'jose.manuel@empresa.com', '1990-11-08', 2200000, 0.00, 1, 1, 1),
+('Ana', 'Patricia', 'ana.patricia@empresa.com', '1988-04-12', 3500000, 0.00, 4, 2, 4),
+('Luis', 'Fernando', 'luis.fernando@empresa.com', '1987-09-30', 4000000, 0.00, 2, 1, 5);
@@ -0,0 +1,30 @@
+-- Auditoría de solo inserción, como 0016_auditoria de Postgres. Las fechas se
+-- guardan como texto UTC con milisegundos para que ordenen y se comparen
+-- como fechas.
+
+CREATE TABLE auditoria (
+    audit_ID INTEGER PRIMARY KEY,
+    audit_fecha TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    audit_operador VARCHAR(100) NOT NULL,
+    audit_cliente VARCHAR(100) NOT NULL,
+    audit_operacion VARCHAR(30) NOT NULL,
+    audit_empl_ID INTEGER,
+    audit_antes TEXT,
+    audit_despues TEXT
+);
+
+CREATE INDEX idx_auditoria_empleado ON auditoria(audit_empl_ID);
+CREATE INDEX idx_auditoria_operador ON auditoria(audit_operador);
+CREATE INDEX idx_auditoria_fecha ON auditoria(audit_fecha);
+
+CREATE TRIGGER tr_auditoria_sin_update
+BEFORE UPDATE ON auditoria
+BEGIN
+    SELECT RAISE(ABORT, 'La auditoría es de solo inserción');
+END;
+
+CREATE TRIGGER tr_auditoria_sin_delete
+BEFORE DELETE ON auditoria
+BEGIN
+    SELECT RAISE(ABORT, 'La auditoría es de solo inserción');
+END;
@@ -0,0 +1,3 @@
+DROP TABLE usuarios;
+DROP TABLE rol_permisos;
+DROP TABLE roles;
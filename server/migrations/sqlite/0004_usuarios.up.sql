@@ -0,0 +1,64 @@
+-- Usuarios, roles y permisos: el estado final de las migraciones 0017 a 0020
+-- de Postgres. SUBSCRIBE no se concede porque SQLite no publica eventos.
+
+CREATE TABLE roles (
+    rol_nombre VARCHAR(30) PRIMARY KEY,
+    rol_descripcion VARCHAR(255) NOT NULL DEFAULT '',
+    rol_alcance VARCHAR(20) NOT NULL DEFAULT 'todos'
+        CHECK (rol_alcance IN ('todos', 'departamento', 'propio'))
+);
+
+CREATE TABLE rol_permisos (
+    rolperm_rol VARCHAR(30) NOT NULL,
+    rolperm_permiso VARCHAR(50) NOT NULL,
+    PRIMARY KEY (rolperm_rol, rolperm_permiso),
+    FOREIGN KEY (rolperm_rol) REFERENCES roles(rol_nombre) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE usuarios (
+    usr_ID INTEGER PRIMARY KEY,
+    usr_nombre VARCHAR(50) NOT NULL UNIQUE,
+    usr_password_hash VARCHAR(100) NOT NULL,
+    usr_rol VARCHAR(30) NOT NULL DEFAULT 'self_service',
+    usr_activo BOOLEAN NOT NULL DEFAULT TRUE,
+    usr_creado TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    usr_empl_ID INTEGER,
+    FOREIGN KEY (usr_rol) REFERENCES roles(rol_nombre) ON DELETE RESTRICT ON UPDATE CASCADE,
+    FOREIGN KEY (usr_empl_ID) REFERENCES empleados(empl_ID) ON DELETE SET NULL
+);
+
+INSERT INTO roles (rol_nombre, rol_descripcion, rol_alcance) VALUES
+('admin', 'Acceso total, incluida la gestión de usuarios y roles', 'todos'),
+('hr_manager', 'Gestión completa de empleados y consulta de auditoría', 'todos'),
+('hr_analyst', 'Consulta de empleados, catálogos y auditoría', 'todos'),
+('department_manager', 'Consulta y edición parcial de empleados', 'departamento'),
+('self_service', 'Consulta de datos propios y catálogos', 'propio');
+
+INSERT INTO rol_permisos (rolperm_rol, rolperm_permiso) VALUES
+('admin', '*'),
+('hr_manager', 'INSERT'),
+('hr_manager', 'UPDATE'),
+('hr_manager', 'PATCH'),
+('hr_manager', 'SELECT'),
+('hr_manager', 'DELETE'),
+('hr_manager', 'RESTORE'),
+('hr_manager', 'BATCH'),
+('hr_manager', 'LIST_AUDIT'),
+('hr_manager', 'LIST_CARGOS'),
+('hr_manager', 'LIST_DEPARTAMENTOS_CON_DATOS'),
+('hr_manager', 'LIST_GERENTES'),
+('hr_analyst', 'SELECT'),
+('hr_analyst', 'LIST_AUDIT'),
+('hr_analyst', 'LIST_CARGOS'),
+('hr_analyst', 'LIST_DEPARTAMENTOS_CON_DATOS'),
+('hr_analyst', 'LIST_GERENTES'),
+('department_manager', 'SELECT'),
+('department_manager', 'PATCH'),
+('department_manager', 'LIST_CARGOS'),
+('department_manager', 'LIST_DEPARTAMENTOS_CON_DATOS'),
+('department_manager', 'LIST_GERENTES'),
+('self_service', 'SELECT'),
+('self_service', 'LIST_CARGOS'),
+('self_service', 'LIST_DEPARTAMENTOS_CON_DATOS'),
+('hr_manager', 'VER_COMPENSACION'),
+('hr_manager', 'VER_DATOS_PERSONALES');
@@ -0,0 +1,11 @@
+DELETE FROM rol_permisos WHERE rolperm_permiso = 'SUBSCRIBE'
+    AND rolperm_rol IN ('hr_manager', 'hr_analyst', 'department_manager');
+DROP TRIGGER tr_departamentos_evento_delete;
+DROP TRIGGER tr_departamentos_evento_update;
+DROP TRIGGER tr_departamentos_evento_insert;
+DROP TRIGGER tr_cargos_evento_delete;
+DROP TRIGGER tr_cargos_evento_update;
+DROP TRIGGER tr_cargos_evento_insert;
+DROP TRIGGER tr_auditoria_evento;
+DROP INDEX idx_eventos_creado;
+DROP TABLE eventos;
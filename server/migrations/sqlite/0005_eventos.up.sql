@@ -0,0 +1,112 @@
+-- Eventos de cambios para SUBSCRIBE, como 0021_eventos de Postgres. SQLite
+-- no tiene LISTEN/NOTIFY: los triggers guardan cada evento en la tabla
+-- eventos y el servidor la consulta periódicamente (ver pollEvents). Los de
+-- empleados salen de la auditoría, así que solo se publican cambios
+-- confirmados y ya auditados. evt_evento es lo que reciben los suscriptores;
+-- evt_fila, la fila que además reciben los webhooks bajo la clave
+-- evt_entidad. AUTOINCREMENT evita reusar IDs tras purgar la tabla: el
+-- servidor lee los eventos posteriores al último ID publicado.
+
+CREATE TABLE eventos (
+    evt_ID INTEGER PRIMARY KEY AUTOINCREMENT,
+    evt_tipo VARCHAR(50) NOT NULL,
+    evt_entidad VARCHAR(20) NOT NULL,
+    evt_evento TEXT NOT NULL,
+    evt_fila TEXT,
+    evt_creado TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now'))
+);
+
+CREATE INDEX idx_eventos_creado ON eventos(evt_creado);
+
+CREATE TRIGGER tr_auditoria_evento
+AFTER INSERT ON auditoria
+BEGIN
+    INSERT INTO eventos (evt_tipo, evt_entidad, evt_evento, evt_fila)
+    SELECT tipo, 'empleado', json_object(
+        'tipo', tipo,
+        'id', NEW.audit_empl_ID,
+        'dpto_id', COALESCE(json_extract(NEW.audit_despues, '$.empl_dpto_id'),
+                            json_extract(NEW.audit_antes, '$.empl_dpto_id')),
+        'dpto_anterior_id', json_extract(NEW.audit_antes, '$.empl_dpto_id'),
+        'audit_id', NEW.audit_ID,
+        'operador', NEW.audit_operador,
+        'fecha', strftime('%Y-%m-%dT%H:%M:%fZ', NEW.audit_fecha)
+    ), COALESCE(NEW.audit_despues, NEW.audit_antes)
+    FROM (SELECT 'empleado.' || CASE NEW.audit_operacion
+            WHEN 'INSERT' THEN 'created'
+            WHEN 'DELETE' THEN 'deleted'
+            WHEN 'RESTORE' THEN 'restored'
+            ELSE 'updated'
+        END AS tipo);
+END;
+
+-- Cargos y departamentos no se modifican por el protocolo; se notifican los
+-- cambios hechos directamente en la base de datos. SQLite no tiene usuarios
+-- de base de datos: el operador es siempre 'sqlite'.
+CREATE TRIGGER tr_cargos_evento_insert
+AFTER INSERT ON cargos
+BEGIN
+    INSERT INTO eventos (evt_tipo, evt_entidad, evt_evento, evt_fila) VALUES (
+        'cargo.created', 'cargo',
+        json_object('tipo', 'cargo.created', 'id', NEW.cargo_ID, 'operador', 'sqlite',
+                    'fecha', strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+        json_object('cargo_id', NEW.cargo_ID, 'cargo_nombre', NEW.cargo_nombre,
+                    'cargo_sueldo_minimo', NEW.cargo_sueldo_minimo, 'cargo_sueldo_maximo', NEW.cargo_sueldo_maximo));
+END;
+
+CREATE TRIGGER tr_cargos_evento_update
+AFTER UPDATE ON cargos
+BEGIN
+    INSERT INTO eventos (evt_tipo, evt_entidad, evt_evento, evt_fila) VALUES (
+        'cargo.updated', 'cargo',
+        json_object('tipo', 'cargo.updated', 'id', NEW.cargo_ID, 'operador', 'sqlite',
+                    'fecha', strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+        json_object('cargo_id', NEW.cargo_ID, 'cargo_nombre', NEW.cargo_nombre,
+                    'cargo_sueldo_minimo', NEW.cargo_sueldo_minimo, 'cargo_sueldo_maximo', NEW.cargo_sueldo_maximo));
+END;
+
+CREATE TRIGGER tr_cargos_evento_delete
+AFTER DELETE ON cargos
+BEGIN
+    INSERT INTO eventos (evt_tipo, evt_entidad, evt_evento, evt_fila) VALUES (
+        'cargo.deleted', 'cargo',
+        json_object('tipo', 'cargo.deleted', 'id', OLD.cargo_ID, 'operador', 'sqlite',
+                    'fecha', strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+        json_object('cargo_id', OLD.cargo_ID, 'cargo_nombre', OLD.cargo_nombre,
+                    'cargo_sueldo_minimo', OLD.cargo_sueldo_minimo, 'cargo_sueldo_maximo', OLD.cargo_sueldo_maximo));
+END;
+
+CREATE TRIGGER tr_departamentos_evento_insert
+AFTER INSERT ON departamentos
+BEGIN
+    INSERT INTO eventos (evt_tipo, evt_entidad, evt_evento, evt_fila) VALUES (
+        'departamento.created', 'departamento',
+        json_object('tipo', 'departamento.created', 'id', NEW.dpto_ID, 'dpto_id', NEW.dpto_ID,
+                    'operador', 'sqlite', 'fecha', strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+        json_object('dpto_id', NEW.dpto_ID, 'dpto_localiz_id', NEW.dpto_localiz_ID, 'dpto_nombre', NEW.dpto_nombre));
+END;
+
+CREATE TRIGGER tr_departamentos_evento_update
+AFTER UPDATE ON departamentos
+BEGIN
+    INSERT INTO eventos (evt_tipo, evt_entidad, evt_evento, evt_fila) VALUES (
+        'departamento.updated', 'departamento',
+        json_object('tipo', 'departamento.updated', 'id', NEW.dpto_ID, 'dpto_id', NEW.dpto_ID,
+                    'operador', 'sqlite', 'fecha', strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+        json_object('dpto_id', NEW.dpto_ID, 'dpto_localiz_id', NEW.dpto_localiz_ID, 'dpto_nombre', NEW.dpto_nombre));
+END;
+
+CREATE TRIGGER tr_departamentos_evento_delete
+AFTER DELETE ON departamentos
+BEGIN
+    INSERT INTO eventos (evt_tipo, evt_entidad, evt_evento, evt_fila) VALUES (
+        'departamento.deleted', 'departamento',
+        json_object('tipo', 'departamento.deleted', 'id', OLD.dpto_ID, 'dpto_id', OLD.dpto_ID,
+                    'operador', 'sqlite', 'fecha', strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+        json_object('dpto_id', OLD.dpto_ID, 'dpto_localiz_id', OLD.dpto_localiz_ID, 'dpto_nombre', OLD.dpto_nombre));
+END;
+
+INSERT INTO rol_permisos (rolperm_rol, rolperm_permiso) VALUES
+('hr_manager', 'SUBSCRIBE'),
+('hr_analyst', 'SUBSCRIBE'),
+('department_manager', 'SUBSCRIBE');
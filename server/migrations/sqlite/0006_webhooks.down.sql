@@ -0,0 +1,3 @@
+DROP TRIGGER tr_eventos_webhooks;
+DROP TABLE webhook_outbox;
+DROP TABLE webhooks;
@@ -0,0 +1,45 @@
+-- Webhooks salientes, como 0022_webhooks de Postgres. Cada evento de
+-- 0005_eventos se encola en webhook_outbox dentro de la misma transacción
+-- que el cambio (outbox transaccional); el servidor los entrega y reintenta.
+-- wh_tipos es un arreglo JSON de texto, porque SQLite no tiene arreglos.
+
+CREATE TABLE webhooks (
+    wh_ID INTEGER PRIMARY KEY,
+    wh_url VARCHAR(500) NOT NULL,
+    wh_tipos TEXT NOT NULL DEFAULT '[]' CHECK (json_type(wh_tipos) = 'array'),
+    wh_secreto VARCHAR(200) NOT NULL,
+    wh_activo BOOLEAN NOT NULL DEFAULT TRUE,
+    wh_creado TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now'))
+);
+
+CREATE TABLE webhook_outbox (
+    out_ID INTEGER PRIMARY KEY,
+    out_wh_ID INTEGER NOT NULL REFERENCES webhooks(wh_ID) ON DELETE CASCADE,
+    out_tipo VARCHAR(50) NOT NULL,
+    out_payload TEXT NOT NULL,
+    out_estado VARCHAR(20) NOT NULL DEFAULT 'pendiente'
+        CHECK (out_estado IN ('pendiente', 'entregado', 'fallido')),
+    out_intentos INTEGER NOT NULL DEFAULT 0,
+    out_proximo TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    out_ultimo_error TEXT,
+    out_creado TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    out_entregado TIMESTAMP
+);
+
+CREATE INDEX idx_webhook_outbox_pendientes ON webhook_outbox(out_proximo) WHERE out_estado = 'pendiente';
+CREATE INDEX idx_webhook_outbox_fallidos ON webhook_outbox(out_ID) WHERE out_estado = 'fallido';
+
+-- wh_tipos vacío recibe todos los eventos; si no, acepta tipos exactos
+-- ('empleado.created') o entidades ('empleado', 'empleado.*'). Los webhooks
+-- reciben además la fila bajo la clave de la entidad.
+CREATE TRIGGER tr_eventos_webhooks
+AFTER INSERT ON eventos
+BEGIN
+    INSERT INTO webhook_outbox (out_wh_ID, out_tipo, out_payload)
+    SELECT wh_ID, NEW.evt_tipo, json_set(NEW.evt_evento, '$.' || NEW.evt_entidad, json(NEW.evt_fila))
+    FROM webhooks
+    WHERE wh_activo
+      AND (json_array_length(wh_tipos) = 0
+           OR EXISTS (SELECT 1 FROM json_each(wh_tipos)
+                      WHERE value IN (NEW.evt_tipo, NEW.evt_entidad, NEW.evt_entidad || '.*')));
+END;
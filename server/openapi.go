@@ -0,0 +1,338 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"hr-system/shared"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+//go:embed docs.html
+var docsPage []byte
+
+// schema es un objeto Schema de OpenAPI 3.0.
+type schema map[string]any
+
+// fieldLimits documenta las reglas de shared/validation.go por nombre de
+// campo JSON, para que los clientes generados validen lo mismo que el
+// servidor.
+var fieldLimits = map[string]schema{
+	"empl_primer_nombre":  {"minLength": 1, "maxLength": shared.MaxPrimerNombreLen},
+	"empl_segundo_nombre": {"maxLength": shared.MaxSegundoNombreLen},
+	"empl_email":          {"format": "email", "maxLength": shared.MaxEmailLen},
+	"empl_fecha_nac":      {"format": "date"},
+	"empl_sueldo":         {"minimum": shared.MinSueldo, "maximum": shared.MaxSueldo},
+	"empl_comision":       {"minimum": shared.MinComision, "maximum": shared.MaxComision},
+	"empl_version":        {"minimum": 1},
+	"usuario":             {"minLength": 1, "maxLength": shared.MaxUsuarioLen, "pattern": shared.UsuarioPattern},
+	"password":            {"minLength": shared.MinPasswordLen, "maxLength": shared.MaxPasswordLen},
+	"rol":                 {"maxLength": shared.MaxRolLen, "pattern": shared.RolPattern},
+	"rol_nombre":          {"maxLength": shared.MaxRolLen, "pattern": shared.RolPattern},
+	"rol_alcance":         {"enum": []string{shared.AlcanceTodos, shared.AlcanceDepartamento, shared.AlcancePropio}},
+	"alcance":             {"enum": []string{shared.AlcanceTodos, shared.AlcanceDepartamento, shared.AlcancePropio}},
+	"desde":               {"format": "date"},
+	"hasta":               {"format": "date"},
+	"limit":               {"minimum": 1, "maximum": maxAuditLimit},
+}
+
+// openAPIBuilder genera los esquemas de components a partir de los tipos de
+// shared por reflexión.
+type openAPIBuilder struct {
+	schemas map[string]schema
+}
+
+func (b *openAPIBuilder) ref(v any) schema {
+	return b.schemaFor(reflect.TypeOf(v))
+}
+
+func (b *openAPIBuilder) schemaFor(t reflect.Type) schema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := schema{}
+		for k, v := range b.schemaFor(t.Elem()) {
+			s[k] = v
+		}
+		if _, isRef := s["$ref"]; isRef {
+			return schema{"allOf": []schema{s}, "nullable": true}
+		}
+		s["nullable"] = true
+		return s
+	case reflect.Struct:
+		name := t.Name()
+		if _, ok := b.schemas[name]; !ok {
+			b.schemas[name] = nil
+			b.schemas[name] = b.structSchema(t)
+		}
+		return schema{"$ref": "#/components/schemas/" + name}
+	case reflect.Slice:
+		if t == reflect.TypeOf(json.RawMessage{}) {
+			return schema{"type": "object", "nullable": true, "description": "Fila de la tabla empleados tal cual"}
+		}
+		return schema{"type": "array", "items": b.schemaFor(t.Elem())}
+	case reflect.Map:
+		return schema{"type": "object", "additionalProperties": b.schemaFor(t.Elem())}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int32:
+		return schema{"type": "integer", "format": "int32"}
+	case reflect.Int64:
+		return schema{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number", "format": "double"}
+	default:
+		return schema{}
+	}
+}
+
+func (b *openAPIBuilder) structSchema(t reflect.Type) schema {
+	properties := schema{}
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		prop := b.schemaFor(field.Type)
+		if limits, ok := fieldLimits[name]; ok && prop["$ref"] == nil {
+			for k, v := range limits {
+				prop[k] = v
+			}
+		}
+		properties[name] = prop
+		if !strings.Contains(opts, "omitempty") && field.Type.Kind() != reflect.Pointer {
+			required = append(required, name)
+		}
+	}
+	s := schema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+// envelope describe shared.Response con data del esquema indicado.
+func envelope(data schema) schema {
+	properties := schema{
+		"success": schema{"type": "boolean"},
+		"code": schema{
+			"type": "string",
+			"enum": []string{shared.CodeConflict, shared.CodeUnauthorized, shared.CodeForbidden, shared.CodeNotFound, shared.CodeTimeout, shared.CodeRateLimited, shared.CodeInternal},
+		},
+		"message": schema{"type": "string"},
+	}
+	if data != nil {
+		properties["data"] = data
+	}
+	return schema{"type": "object", "required": []string{"success", "message"}, "properties": properties}
+}
+
+func jsonContent(s schema) schema {
+	return schema{"application/json": schema{"schema": s}}
+}
+
+func operation(summary, tag string, body schema, success string, data schema, params ...schema) schema {
+	op := schema{
+		"summary": summary,
+		"tags":    []string{tag},
+		"responses": schema{
+			success: schema{"description": "Operación exitosa", "content": jsonContent(envelope(data))},
+			"400":   schema{"$ref": "#/components/responses/Error"},
+			"401":   schema{"$ref": "#/components/responses/Error"},
+			"403":   schema{"$ref": "#/components/responses/Error"},
+			"429":   schema{"$ref": "#/components/responses/Error"},
+			"500":   schema{"$ref": "#/components/responses/Error"},
+			"504":   schema{"$ref": "#/components/responses/Error"},
+		},
+	}
+	if body != nil {
+		op["requestBody"] = schema{"required": true, "content": jsonContent(body)}
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	return op
+}
+
+func withResponses(op schema, codes ...string) schema {
+	responses := op["responses"].(schema)
+	for _, code := range codes {
+		responses[code] = schema{"$ref": "#/components/responses/Error"}
+	}
+	return op
+}
+
+func pathParam(name, description string, s schema) schema {
+	return schema{"name": name, "in": "path", "required": true, "description": description, "schema": s}
+}
+
+func queryParam(name, description string, s schema) schema {
+	return schema{"name": name, "in": "query", "description": description, "schema": s}
+}
+
+// buildOpenAPI describe el gateway HTTP de http.go.
+func buildOpenAPI() schema {
+	b := &openAPIBuilder{schemas: map[string]schema{}}
+	emplID := pathParam("id", "ID del empleado", schema{"type": "integer", "minimum": 1})
+	departamento := schema{
+		"type":     "object",
+		"required": []string{"dpto_id", "dpto_nombre", "direccion", "ciudad"},
+		"properties": schema{
+			"dpto_id":     schema{"type": "integer"},
+			"dpto_nombre": schema{"type": "string"},
+			"direccion":   schema{"type": "string"},
+			"ciudad":      schema{"type": "string"},
+		},
+	}
+	conflict := "409"
+
+	paths := schema{
+		"/login": schema{
+			"post": schema{
+				"summary":     "Inicia sesión y devuelve el token Bearer",
+				"tags":        []string{"sesión"},
+				"security":    []schema{},
+				"requestBody": schema{"required": true, "content": jsonContent(b.ref(shared.LoginDTO{}))},
+				"responses": schema{
+					"200": schema{"description": "Sesión iniciada", "content": jsonContent(envelope(b.ref(shared.LoginResponseDTO{})))},
+					"401": schema{"$ref": "#/components/responses/Error"},
+				},
+			},
+		},
+		"/logout": schema{
+			"post": operation("Cierra la sesión", "sesión", nil, "200", nil),
+		},
+		"/health/live": schema{
+			"get": schema{
+				"summary":  "Comprobación de vida: responde mientras el proceso atienda peticiones",
+				"tags":     []string{"salud"},
+				"security": []schema{},
+				"responses": schema{
+					"200": schema{"description": "Servidor vivo", "content": jsonContent(envelope(b.ref(shared.HealthDTO{})))},
+				},
+			},
+		},
+		"/health/ready": schema{
+			"get": schema{
+				"summary":  "Comprobación de disponibilidad: base de datos, esquema y apagado",
+				"tags":     []string{"salud"},
+				"security": []schema{},
+				"responses": schema{
+					"200": schema{"description": "Servidor listo", "content": jsonContent(envelope(b.ref(shared.HealthDTO{})))},
+					"503": schema{"description": "Servidor no listo; data.problemas explica por qué", "content": jsonContent(envelope(b.ref(shared.HealthDTO{})))},
+				},
+			},
+		},
+		"/empleados": schema{
+			"post": operation("Crea un empleado", "empleados",
+				b.ref(shared.CreateEmpleadoDTO{}), "201", b.ref(shared.CreateEmpleadoResponseDTO{})),
+		},
+		"/empleados/{id}": schema{
+			"get": withResponses(operation("Consulta un empleado", "empleados",
+				nil, "200", b.ref(shared.EmpleadoDetailResponseDTO{}), emplID), "404"),
+			"put": withResponses(operation("Reemplaza todos los campos de un empleado", "empleados",
+				b.ref(shared.UpdateEmpleadoDTO{}), "200", b.ref(shared.UpdateEmpleadoResponseDTO{}), emplID), "404", conflict),
+			"patch": withResponses(operation("Modifica solo los campos enviados", "empleados",
+				b.ref(shared.PatchEmpleadoDTO{}), "200", b.ref(shared.UpdateEmpleadoResponseDTO{}), emplID), "404", conflict),
+			"delete": withResponses(operation("Elimina (borrado lógico) un empleado", "empleados",
+				nil, "200", nil, emplID,
+				schema{"name": "If-Match", "in": "header", "description": "Versión leída del empleado", "schema": schema{"type": "integer"}},
+				queryParam("empl_version", "Versión leída del empleado, si no se envía If-Match", schema{"type": "integer"})),
+				"404", conflict),
+		},
+		"/empleados/{id}/restaurar": schema{
+			"post": withResponses(operation("Revierte el borrado lógico", "empleados",
+				b.ref(shared.RestoreEmpleadoDTO{}), "200", b.ref(shared.UpdateEmpleadoResponseDTO{}), emplID), "404", conflict),
+		},
+		"/cargos": schema{
+			"get": operation("Lista los cargos", "catálogos", nil, "200", schema{"type": "array", "items": b.ref(shared.CargoDTO{})}),
+		},
+		"/departamentos": schema{
+			"get": operation("Lista los departamentos con su localización", "catálogos", nil, "200", schema{"type": "array", "items": departamento}),
+		},
+		"/gerentes": schema{
+			"get": operation("Lista los empleados activos que pueden ser gerentes", "catálogos", nil, "200",
+				schema{"type": "array", "items": b.ref(shared.GerenteDTO{})}),
+		},
+		"/auditoria": schema{
+			"get": operation("Consulta la auditoría de empleados", "auditoría", nil, "200",
+				schema{"type": "array", "items": b.ref(shared.AuditEntryDTO{})},
+				queryParam("empl_id", "Filtra por empleado", schema{"type": "integer"}),
+				queryParam("operador", "Filtra por usuario que hizo el cambio", schema{"type": "string"}),
+				queryParam("desde", "Fecha inicial (YYYY-MM-DD)", schema{"type": "string", "format": "date"}),
+				queryParam("hasta", "Fecha final inclusiva (YYYY-MM-DD)", schema{"type": "string", "format": "date"}),
+				queryParam("limit", "Máximo de registros", fieldLimits["limit"])),
+		},
+		"/batch": schema{
+			"post": withResponses(operation("Ejecuta varias operaciones en una transacción", "empleados",
+				b.ref(shared.BatchRequestDTO{}), "200", b.ref(shared.BatchResponseDTO{})), "404", conflict),
+		},
+		"/usuarios": schema{
+			"get": operation("Lista los usuarios", "usuarios", nil, "200", schema{"type": "array", "items": b.ref(shared.UsuarioDTO{})}),
+			"post": operation("Crea un usuario", "usuarios",
+				b.ref(shared.CreateUsuarioDTO{}), "201", b.ref(shared.UsuarioDTO{})),
+		},
+		"/usuarios/{usuario}": schema{
+			"patch": withResponses(operation("Modifica un usuario", "usuarios",
+				b.ref(shared.UpdateUsuarioDTO{}), "200", b.ref(shared.UsuarioDTO{}),
+				pathParam("usuario", "Nombre de usuario", fieldLimits["usuario"])), "404"),
+		},
+		"/roles": schema{
+			"get": operation("Lista los roles y sus permisos", "usuarios", nil, "200", schema{"type": "array", "items": b.ref(shared.RolDTO{})}),
+		},
+		"/roles/{rol}": schema{
+			"put": operation("Crea o reemplaza un rol", "usuarios",
+				b.ref(shared.RolDTO{}), "200", b.ref(shared.RolDTO{}),
+				pathParam("rol", "Nombre del rol", fieldLimits["rol_nombre"])),
+			"delete": withResponses(operation("Elimina un rol sin usuarios", "usuarios", nil, "200", nil,
+				pathParam("rol", "Nombre del rol", fieldLimits["rol_nombre"])), "404"),
+		},
+	}
+
+	return schema{
+		"openapi": "3.0.3",
+		"info": schema{
+			"title":       "API de Recursos Humanos",
+			"version":     "1.0.0",
+			"description": "Gateway HTTP del servidor de recursos humanos. Todas las respuestas usan el sobre shared.Response.",
+		},
+		"security": []schema{{"bearerAuth": []string{}}},
+		"paths":    paths,
+		"components": schema{
+			"schemas": b.schemas,
+			"securitySchemes": schema{
+				"bearerAuth": schema{"type": "http", "scheme": "bearer", "description": "Token devuelto por POST /login"},
+			},
+			"responses": schema{
+				"Error": schema{"description": "Error con código del protocolo", "content": jsonContent(envelope(schema{}))},
+			},
+		},
+	}
+}
+
+var (
+	openAPIOnce sync.Once
+	openAPIJSON []byte
+)
+
+func handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	openAPIOnce.Do(func() {
+		openAPIJSON, _ = json.MarshalIndent(buildOpenAPI(), "", "  ")
+	})
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(openAPIJSON)
+}
+
+func handleDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(docsPage)
+}
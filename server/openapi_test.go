@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"hr-system/shared"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOpenAPIDescribeDTOs(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewServer("0").httpHandler().ServeHTTP(w, httptest.NewRequest("GET", "/openapi.json", nil))
+	if w.Code != 200 {
+		t.Fatalf("GET /openapi.json = %d", w.Code)
+	}
+	var doc struct {
+		OpenAPI    string                    `json:"openapi"`
+		Paths      map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Required   []string                  `json:"required"`
+				Properties map[string]map[string]any `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("la especificación no es JSON válido: %v", err)
+	}
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("openapi = %q", doc.OpenAPI)
+	}
+	for _, path := range []string{"/empleados", "/empleados/{id}", "/cargos", "/departamentos", "/auditoria", "/batch", "/health/ready"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("falta la ruta %s", path)
+		}
+	}
+
+	create, ok := doc.Components.Schemas["CreateEmpleadoDTO"]
+	if !ok {
+		t.Fatal("falta el esquema CreateEmpleadoDTO")
+	}
+	nombre := create.Properties["empl_primer_nombre"]
+	if nombre["maxLength"] != float64(shared.MaxPrimerNombreLen) {
+		t.Errorf("empl_primer_nombre.maxLength = %v", nombre["maxLength"])
+	}
+	if create.Properties["empl_segundo_nombre"]["nullable"] != true {
+		t.Error("empl_segundo_nombre debe ser nullable")
+	}
+	if create.Properties["empl_gerente_id"]["nullable"] != true {
+		t.Error("empl_gerente_id debe ser nullable")
+	}
+	if create.Properties["empl_sueldo"]["maximum"] != shared.MaxSueldo {
+		t.Errorf("empl_sueldo.maximum = %v", create.Properties["empl_sueldo"]["maximum"])
+	}
+	for _, field := range create.Required {
+		if field == "empl_segundo_nombre" || field == "empl_gerente_id" {
+			t.Errorf("%s no debe ser requerido", field)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// testPostgres es un Postgres efímero para las pruebas: initdb en un
+// directorio temporal y postgres escuchando en un puerto libre de 127.0.0.1.
+// Los binarios se buscan en PG_BINDIR o, si no está definida, en el PATH; sin
+// ellos las pruebas que lo necesitan fallan, salvo que HR_TEST_SKIP_POSTGRES=1
+// pida saltarlas explícitamente.
+//
+// Se arranca la primera vez que una prueba lo pide y TestMain lo detiene. Las
+// migraciones, con sus datos iniciales, se aplican una sola vez a la base
+// hr_template, y cada prueba recibe una copia nueva de ella.
+type testPostgres struct {
+	pgCtl   string
+	dir     string
+	port    int
+	skip    string
+	counter atomic.Int64
+}
+
+// skipPostgresEnv permite saltar las pruebas con Postgres cuando no está
+// disponible.
+const skipPostgresEnv = "HR_TEST_SKIP_POSTGRES"
+
+var (
+	testPGOnce sync.Once
+	testPG     *testPostgres
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if testPG != nil {
+		testPG.stop()
+	}
+	os.Exit(code)
+}
+
+// requirePostgres devuelve el Postgres compartido. Si no se puede arrancar, la
+// prueba falla: en CI un Postgres ausente no debe pasar por una prueba
+// superada. Con HR_TEST_SKIP_POSTGRES=1 se salta en su lugar.
+func requirePostgres(t *testing.T) *testPostgres {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("pruebas con Postgres omitidas en modo -short")
+	}
+	testPGOnce.Do(func() {
+		testPG = startTestPostgres()
+	})
+	if testPG.skip != "" {
+		if os.Getenv(skipPostgresEnv) == "1" {
+			t.Skip(testPG.skip)
+		}
+		t.Fatalf("%s (defina %s=1 para saltar las pruebas con Postgres)", testPG.skip, skipPostgresEnv)
+	}
+	return testPG
+}
+
+func pgBinary(name string) (string, error) {
+	if dir := os.Getenv("PG_BINDIR"); dir != "" {
+		return exec.LookPath(filepath.Join(dir, name))
+	}
+	return exec.LookPath(name)
+}
+
+func startTestPostgres() *testPostgres {
+	pg := &testPostgres{}
+	initdb, err := pgBinary("initdb")
+	if err != nil {
+		pg.skip = fmt.Sprintf("no se encontró initdb (defina PG_BINDIR): %v", err)
+		return pg
+	}
+	if pg.pgCtl, err = pgBinary("pg_ctl"); err != nil {
+		pg.skip = fmt.Sprintf("no se encontró pg_ctl (defina PG_BINDIR): %v", err)
+		return pg
+	}
+	if os.Geteuid() == 0 {
+		pg.skip = "Postgres no puede ejecutarse como root"
+		return pg
+	}
+	if pg.dir, err = os.MkdirTemp("", "hr-postgres-"); err != nil {
+		pg.skip = fmt.Sprintf("error creando el directorio de datos: %v", err)
+		return pg
+	}
+	if pg.port, err = freePort(); err != nil {
+		pg.skip = err.Error()
+		return pg
+	}
+	data := filepath.Join(pg.dir, "data")
+	out, err := exec.Command(initdb, "-D", data, "-U", "postgres", "-A", "trust", "-E", "UTF8",
+		"--no-sync").CombinedOutput()
+	if err != nil {
+		pg.skip = fmt.Sprintf("error en initdb: %v\n%s", err, out)
+		return pg
+	}
+	options := fmt.Sprintf("-p %d -k %s -c listen_addresses=127.0.0.1 -c fsync=off", pg.port, pg.dir)
+	out, err = exec.Command(pg.pgCtl, "-D", data, "-l", filepath.Join(pg.dir, "postgres.log"),
+		"-o", options, "-w", "start").CombinedOutput()
+	if err != nil {
+		pg.skip = fmt.Sprintf("error arrancando Postgres: %v\n%s", err, out)
+		return pg
+	}
+	if err := pg.createTemplate(); err != nil {
+		pg.skip = err.Error()
+	}
+	return pg
+}
+
+func (pg *testPostgres) stop() {
+	if pg.dir == "" {
+		return
+	}
+	exec.Command(pg.pgCtl, "-D", filepath.Join(pg.dir, "data"), "-m", "immediate", "stop").Run()
+	os.RemoveAll(pg.dir)
+}
+
+func freePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, fmt.Errorf("error buscando un puerto libre: %v", err)
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+func (pg *testPostgres) dsn(dbname string) string {
+	return fmt.Sprintf("host=127.0.0.1 port=%d user=postgres dbname=%s sslmode=disable", pg.port, dbname)
+}
+
+func (pg *testPostgres) createTemplate() error {
+	admin, err := sql.Open("postgres", pg.dsn("postgres"))
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	if _, err := admin.Exec(`CREATE DATABASE hr_template`); err != nil {
+		return fmt.Errorf("error creando hr_template: %v", err)
+	}
+	db, err := sql.Open("postgres", pg.dsn("hr_template"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	migrator, err := NewMigrator(db, dialectPostgres)
+	if err != nil {
+		return err
+	}
+	if _, err := migrator.Up(); err != nil {
+		return fmt.Errorf("error migrando hr_template: %v", err)
+	}
+	return nil
+}
+
+// newDatabase crea una base de datos con el esquema y los datos iniciales,
+// que se elimina al terminar la prueba.
+func (pg *testPostgres) newDatabase(t *testing.T) (*sql.DB, dbConfig) {
+	t.Helper()
+	name := fmt.Sprintf("hr_test_%d", pg.counter.Add(1))
+	admin, err := sql.Open("postgres", pg.dsn("postgres"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+	if _, err := admin.Exec(fmt.Sprintf(`CREATE DATABASE %s TEMPLATE hr_template`, name)); err != nil {
+		t.Fatalf("error creando %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec(fmt.Sprintf(`DROP DATABASE %s WITH (FORCE)`, name)); err != nil {
+			t.Errorf("error eliminando %s: %v", name, err)
+		}
+	})
+	cfg := dbConfig{dialect: dialectPostgres, dsn: pg.dsn(name)}
+	db, err := sql.Open("postgres", cfg.dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, cfg
+}
+
+func newPostgresTestStore(t *testing.T) testStore {
+	t.Helper()
+	db, _ := requirePostgres(t).newDatabase(t)
+	return newSQLTestStore(t, db, NewPostgresStore(db))
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"hr-system/shared"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// operaciones son las operaciones del protocolo sujetas a permisos. LOGIN,
+// LOGOUT y UNSUBSCRIBE no requieren permiso.
+var operaciones = []string{
+	"INSERT", "UPDATE", "PATCH", "SELECT", "DELETE", "RESTORE", "BATCH",
+	"LIST_AUDIT", "LIST_CARGOS", "LIST_DEPARTAMENTOS_CON_DATOS", "LIST_GERENTES",
+	"CREATE_USUARIO", "UPDATE_USUARIO", "LIST_USUARIOS",
+	"LIST_ROLES", "SAVE_ROL", "DELETE_ROL", "SUBSCRIBE",
+	"CREATE_WEBHOOK", "LIST_WEBHOOKS", "UPDATE_WEBHOOK", "DELETE_WEBHOOK", "LIST_ENTREGAS_FALLIDAS",
+	"RETRY_ENTREGA",
+}
+
+// rbacReloadInterval limita cuánto tarda en aplicarse un cambio hecho
+// directamente en la base de datos.
+const rbacReloadInterval = time.Minute
+
+func isOperacion(op string) bool {
+	for _, o := range operaciones {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+func isPermiso(permiso string) bool {
+	if permiso == shared.PermisoTodo || isOperacion(permiso) {
+		return true
+	}
+	for _, p := range shared.PermisoPorCategoria {
+		if p == permiso {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorizer mantiene en memoria la matriz de permisos de las tablas roles y
+// rol_permisos.
+type Authorizer struct {
+	db       *sql.DB
+	mu       sync.RWMutex
+	permisos map[string]map[string]bool
+	loadedAt time.Time
+}
+
+func NewAuthorizer(db *sql.DB) *Authorizer {
+	return &Authorizer{db: db}
+}
+
+func (a *Authorizer) Load(ctx context.Context) error {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT r.rol_nombre, rp.rolperm_permiso
+		FROM roles r
+		LEFT JOIN rol_permisos rp ON rp.rolperm_rol = r.rol_nombre`)
+	if err != nil {
+		return internalError("error cargando permisos: %v", err)
+	}
+	defer rows.Close()
+	permisos := make(map[string]map[string]bool)
+	for rows.Next() {
+		var rol string
+		var permiso sql.NullString
+		if err := rows.Scan(&rol, &permiso); err != nil {
+			return internalError("error escaneando permiso: %v", err)
+		}
+		if permisos[rol] == nil {
+			permisos[rol] = make(map[string]bool)
+		}
+		if permiso.Valid {
+			permisos[rol][permiso.String] = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return internalError("error cargando permisos: %v", err)
+	}
+	a.mu.Lock()
+	a.permisos = permisos
+	a.loadedAt = time.Now()
+	a.mu.Unlock()
+	return nil
+}
+
+// Allowed indica si rol puede ejecutar op. admin siempre puede.
+func (a *Authorizer) Allowed(ctx context.Context, rol, op string) bool {
+	if rol == shared.RolAdmin {
+		return true
+	}
+	a.mu.RLock()
+	stale := time.Since(a.loadedAt) > rbacReloadInterval
+	a.mu.RUnlock()
+	if stale {
+		if err := a.Load(ctx); err != nil {
+			log.Printf("Error recargando permisos, se usa la matriz anterior: %v", err)
+		}
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	permisos := a.permisos[rol]
+	return permisos[shared.PermisoTodo] || permisos[op]
+}
+
+// HiddenCategories devuelve las categorías de CamposSensibles que rol no
+// puede ver.
+func (a *Authorizer) HiddenCategories(ctx context.Context, rol string) map[string]bool {
+	ocultas := make(map[string]bool)
+	for categoria, permiso := range shared.PermisoPorCategoria {
+		if !a.Allowed(ctx, rol, permiso) {
+			ocultas[categoria] = true
+		}
+	}
+	return ocultas
+}
+
+func (a *Authorizer) ListRoles(ctx context.Context) ([]shared.RolDTO, error) {
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT r.rol_nombre, r.rol_descripcion, r.rol_alcance, rp.rolperm_permiso
+		FROM roles r
+		LEFT JOIN rol_permisos rp ON rp.rolperm_rol = r.rol_nombre
+		ORDER BY r.rol_nombre, rp.rolperm_permiso`)
+	if err != nil {
+		return nil, internalError("error consultando roles: %v", err)
+	}
+	defer rows.Close()
+	var roles []shared.RolDTO
+	for rows.Next() {
+		var nombre, descripcion, alcance string
+		var permiso sql.NullString
+		if err := rows.Scan(&nombre, &descripcion, &alcance, &permiso); err != nil {
+			return nil, internalError("error escaneando rol: %v", err)
+		}
+		if len(roles) == 0 || roles[len(roles)-1].Nombre != nombre {
+			roles = append(roles, shared.RolDTO{
+				Nombre:      nombre,
+				Descripcion: descripcion,
+				Alcance:     alcance,
+				Permisos:    []string{},
+			})
+		}
+		if permiso.Valid {
+			rol := &roles[len(roles)-1]
+			rol.Permisos = append(rol.Permisos, permiso.String)
+		}
+	}
+	return roles, nil
+}
+
+// SaveRol crea el rol o reemplaza su descripción y permisos.
+func (a *Authorizer) SaveRol(ctx context.Context, dto shared.RolDTO) (*shared.RolDTO, error) {
+	dto.Nombre = strings.TrimSpace(dto.Nombre)
+	if err := shared.ValidateRol(dto.Nombre); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
+	}
+	if dto.Nombre == shared.RolAdmin {
+		return nil, fmt.Errorf("el rol %s no puede modificarse", shared.RolAdmin)
+	}
+	if dto.Alcance == "" {
+		dto.Alcance = shared.AlcanceTodos
+	}
+	if err := shared.ValidateAlcance(dto.Alcance); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
+	}
+	for _, permiso := range dto.Permisos {
+		if !isPermiso(permiso) {
+			return nil, fmt.Errorf("validación fallida: permiso '%s' no válido", permiso)
+		}
+	}
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, internalError("error iniciando transacción: %v", err)
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO roles (rol_nombre, rol_descripcion, rol_alcance) VALUES ($1, $2, $3)
+		ON CONFLICT (rol_nombre) DO UPDATE
+		SET rol_descripcion = EXCLUDED.rol_descripcion, rol_alcance = EXCLUDED.rol_alcance`,
+		dto.Nombre, dto.Descripcion, dto.Alcance)
+	if err != nil {
+		return nil, internalError("error guardando rol: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM rol_permisos WHERE rolperm_rol=$1`, dto.Nombre); err != nil {
+		return nil, internalError("error guardando permisos: %v", err)
+	}
+	for _, permiso := range dto.Permisos {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO rol_permisos (rolperm_rol, rolperm_permiso) VALUES ($1, $2)
+			ON CONFLICT DO NOTHING`, dto.Nombre, permiso)
+		if err != nil {
+			return nil, internalError("error guardando permisos: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, internalError("error confirmando rol: %v", err)
+	}
+	if err := a.Load(ctx); err != nil {
+		return nil, err
+	}
+	if dto.Permisos == nil {
+		dto.Permisos = []string{}
+	}
+	return &dto, nil
+}
+
+func (a *Authorizer) DeleteRol(ctx context.Context, nombre string) error {
+	if nombre == shared.RolAdmin {
+		return fmt.Errorf("el rol %s no puede eliminarse", shared.RolAdmin)
+	}
+	result, err := a.db.ExecContext(ctx, `DELETE FROM roles WHERE rol_nombre=$1`, nombre)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("el rol tiene usuarios asignados")
+		}
+		return internalError("error eliminando rol: %v", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return notFound("rol no encontrado")
+	}
+	return a.Load(ctx)
+}
+
+// authorize responde FORBIDDEN si el rol de la sesión no puede ejecutar op.
+func (s *Server) authorize(ctx context.Context, sess *session, op string) (shared.Response, bool) {
+	if !s.authz.Allowed(ctx, sess.user.Rol, op) {
+		return shared.Response{
+			Success: false,
+			Code:    shared.CodeForbidden,
+			Message: fmt.Sprintf("El rol %s no tiene permiso para %s", sess.user.Rol, op),
+		}, false
+	}
+	return shared.Response{}, true
+}
+
+// revokeRolSessions revoca las sesiones de los usuarios de rol, que guardan
+// su alcance desde LOGIN, para que el cambio del rol se aplique ya.
+func (s *Server) revokeRolSessions(ctx context.Context, rol string) error {
+	usuarios, err := s.usuarios.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, u := range usuarios {
+		if u.Rol == rol {
+			s.revokeUserSessions(u.Usuario)
+		}
+	}
+	return nil
+}
+
+func (s *Server) handleListRoles(ctx context.Context) shared.Response {
+	roles, err := s.authz.ListRoles(ctx)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Lista de roles obtenida",
+		Data:    roles,
+	}
+}
+
+func (s *Server) handleSaveRol(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.RolDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	result, err := s.authz.SaveRol(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
+	if err := s.revokeRolSessions(ctx, result.Nombre); err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Rol guardado exitosamente",
+		Data:    result,
+	}
+}
+
+func (s *Server) handleDeleteRol(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.DeleteRolDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	if err := s.authz.DeleteRol(ctx, dto.Nombre); err != nil {
+		return errorResponse(err)
+	}
+	if err := s.revokeRolSessions(ctx, dto.Nombre); err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Rol eliminado exitosamente",
+	}
+}
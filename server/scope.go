@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"hr-system/shared"
+)
+
+var errFueraDeAlcance = errors.New("el empleado quedaría fuera de su alcance")
+
+var (
+	errRegistroPropio = &OperationError{Code: shared.CodeForbidden,
+		Message: "No puede modificar su propio registro de empleado"}
+	errCompensacion = &OperationError{Code: shared.CodeForbidden,
+		Message: "Su rol no puede modificar sueldo ni comisión sin el permiso " + shared.PermisoVerCompensacion}
+)
+
+// inScope indica si el empleado id existe y está dentro del alcance del
+// actor (ver scopeCondition).
+func (c *EmpleadoCrud) inScope(ctx context.Context, id int) (bool, error) {
+	return c.store.InScope(ctx, id, c.actor)
+}
+
+// checkScope comprueba que el actor pueda modificar el empleado id. Responde
+// como si no existiera cuando está fuera de su alcance, para no revelar
+// datos de otros departamentos. El alcance incluye al propio empleado del
+// actor, que puede verse pero no modificarse.
+func (c *EmpleadoCrud) checkScope(ctx context.Context, id int) error {
+	ok, err := c.inScope(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return notFound("empleado no encontrado")
+	}
+	if c.actor.EmplID != nil && *c.actor.EmplID == id {
+		return errRegistroPropio
+	}
+	return nil
+}
+
+// checkCompensacion impide fijar sueldo o comisión a quien no puede verlos.
+func (c *EmpleadoCrud) checkCompensacion(cambia bool) error {
+	if cambia && c.actor.Ocultas[shared.CategoriaCompensacion] {
+		return errCompensacion
+	}
+	return nil
+}
+
+// checkStillInScope impide que un cambio deje al empleado fuera del alcance
+// de quien lo hace, p. ej. un jefe moviéndolo a otro departamento.
+func (c *EmpleadoCrud) checkStillInScope(ctx context.Context, id int) error {
+	ok, err := c.inScope(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errFueraDeAlcance
+	}
+	return nil
+}
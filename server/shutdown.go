@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+const defaultShutdownGrace = 30 * time.Second
+
+var errShuttingDown = errors.New("el servidor se está apagando")
+
+// lifecycle coordina el apagado del servidor: deja de aceptar conexiones,
+// espera a las peticiones en curso y, si vence el plazo de gracia, las
+// cancela y cierra las conexiones que queden.
+type lifecycle struct {
+	mu        sync.Mutex
+	closing   bool
+	listeners []net.Listener
+	conns     map[net.Conn]struct{}
+	// hooks apagan los servicios auxiliares (HTTP y gRPC) dentro del plazo
+	// de su contexto.
+	hooks   []func(ctx context.Context)
+	clients sync.WaitGroup
+	workers sync.WaitGroup
+
+	// shuttingDown se cierra al empezar el apagado y done al terminar.
+	shuttingDown chan struct{}
+	done         chan struct{}
+	// requestCtx es el padre del contexto de cada conexión; abortRequests lo
+	// cancela cuando vence el plazo de gracia.
+	requestCtx    context.Context
+	abortRequests context.CancelFunc
+	// workerCtx detiene los procesos de fondo (eventos y webhooks).
+	workerCtx   context.Context
+	stopWorkers context.CancelFunc
+}
+
+func newLifecycle() *lifecycle {
+	l := &lifecycle{
+		conns:        make(map[net.Conn]struct{}),
+		shuttingDown: make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	l.requestCtx, l.abortRequests = context.WithCancel(context.Background())
+	l.workerCtx, l.stopWorkers = context.WithCancel(context.Background())
+	return l
+}
+
+// addListener registra un listener para cerrarlo al apagar. Devuelve false
+// si el apagado ya empezó.
+func (l *lifecycle) addListener(listener net.Listener) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closing {
+		return false
+	}
+	l.listeners = append(l.listeners, listener)
+	return true
+}
+
+// addConn registra una conexión de cliente, que el apagado espera. Falla con
+// errShuttingDown si el apagado ya empezó, o con errTooManyConns si ya hay
+// max conexiones; max cero no limita.
+func (l *lifecycle) addConn(conn net.Conn, max int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closing {
+		return errShuttingDown
+	}
+	if max > 0 && len(l.conns) >= max {
+		return errTooManyConns
+	}
+	l.conns[conn] = struct{}{}
+	l.clients.Add(1)
+	return nil
+}
+
+func (l *lifecycle) removeConn(conn net.Conn) {
+	l.mu.Lock()
+	delete(l.conns, conn)
+	l.mu.Unlock()
+	l.clients.Done()
+}
+
+// openConns es el número de conexiones de socket abiertas.
+func (l *lifecycle) openConns() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.conns)
+}
+
+// onShutdown registra hook para apagar un servicio auxiliar. Devuelve false
+// si el apagado ya empezó y el servicio no debe arrancar.
+func (l *lifecycle) onShutdown(hook func(ctx context.Context)) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closing {
+		return false
+	}
+	l.hooks = append(l.hooks, hook)
+	return true
+}
+
+// goWorker ejecuta un proceso de fondo que termina cuando se cancela ctx.
+func (l *lifecycle) goWorker(worker func(ctx context.Context)) {
+	l.workers.Add(1)
+	go func() {
+		defer l.workers.Done()
+		worker(l.workerCtx)
+	}()
+}
+
+func (l *lifecycle) isShuttingDown() bool {
+	select {
+	case <-l.shuttingDown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Shutdown apaga el servidor: deja de aceptar conexiones, avisa a los
+// clientes inactivos y espera a que terminen las peticiones en curso. Si ctx
+// vence antes, cancela esas peticiones, con lo que sus transacciones se
+// revierten, y cierra las conexiones. Al final detiene los procesos de
+// fondo. La base de datos la cierra Start cuando serve termina.
+func (s *Server) Shutdown(ctx context.Context) error {
+	l := s.life
+	l.mu.Lock()
+	if l.closing {
+		l.mu.Unlock()
+		<-l.done
+		return nil
+	}
+	l.closing = true
+	close(l.shuttingDown)
+	listeners, hooks := l.listeners, l.hooks
+	l.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener.Close()
+	}
+	var services sync.WaitGroup
+	for _, hook := range hooks {
+		services.Add(1)
+		go func(hook func(ctx context.Context)) {
+			defer services.Done()
+			hook(ctx)
+		}(hook)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		l.clients.Wait()
+		close(drained)
+	}()
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		l.abortRequests()
+		l.mu.Lock()
+		pending := len(l.conns)
+		for conn := range l.conns {
+			conn.Close()
+		}
+		l.mu.Unlock()
+		err = fmt.Errorf("plazo de apagado vencido con %d conexiones activas: %v", pending, ctx.Err())
+		<-drained
+	}
+	services.Wait()
+	l.stopWorkers()
+	l.workers.Wait()
+	close(l.done)
+	return err
+}
+
+// nextRequest espera la siguiente petición de reader. Si antes empieza el
+// apagado, devuelve errShuttingDown.
+func (s *Server) nextRequest(reader *requestReader) (shared.Request, error) {
+	select {
+	case req, ok := <-reader.requests:
+		if !ok {
+			return shared.Request{}, reader.err
+		}
+		return req, nil
+	case <-s.life.shuttingDown:
+		return shared.Request{}, errShuttingDown
+	}
+}
+
+// sendShutdownNotice avisa al cliente que su conexión se cierra porque el
+// servidor se está apagando.
+func sendShutdownNotice(encoder *json.Encoder, clientAddr string) {
+	err := encoder.Encode(shared.Response{
+		Success: false,
+		Code:    shared.CodeShuttingDown,
+		Message: "El servidor se está apagando; vuelva a conectarse más tarde",
+	})
+	if err != nil {
+		log.Printf("Error avisando el apagado a %s: %v", clientAddr, err)
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"hr-system/hrclient"
+	"hr-system/shared"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// blockingStore detiene ListCargos hasta que la prueba cierra release o se
+// cancela la petición, para tener una operación en curso durante el apagado.
+type blockingStore struct {
+	EmpleadoStore
+	started  chan struct{}
+	release  chan struct{}
+	canceled chan error
+}
+
+func (b *blockingStore) ListCargos(ctx context.Context) ([]shared.CargoDTO, error) {
+	close(b.started)
+	select {
+	case <-b.release:
+		return b.EmpleadoStore.ListCargos(ctx)
+	case <-ctx.Done():
+		b.canceled <- ctx.Err()
+		return nil, ctx.Err()
+	}
+}
+
+// startBlockingServer arranca un servidor sobre SQLite cuyo LIST_CARGOS se
+// bloquea, y lanza esa operación con un cliente. Devuelve cuando la
+// operación ya está en curso; su resultado llega por el canal.
+func startBlockingServer(t *testing.T) (*Server, string, *blockingStore, <-chan error) {
+	t.Helper()
+	s := newDBTestServer(t, openSQLiteTestDB(t), dbConfig{dialect: dialectSQLite})
+	store := &blockingStore{
+		EmpleadoStore: s.crud.store,
+		started:       make(chan struct{}),
+		release:       make(chan struct{}),
+		canceled:      make(chan error, 1),
+	}
+	s.crud = NewEmpleadoCrud(store)
+	addr := serveForTest(t, s)
+	busy := dialAdmin(t, addr, 1)
+	result := make(chan error, 1)
+	go func() {
+		_, err := busy.ListCargos(context.Background())
+		result <- err
+	}()
+	<-store.started
+	return s, addr, store, result
+}
+
+func TestShutdownDrainsInFlightRequests(t *testing.T) {
+	s, addr, store, result := startBlockingServer(t)
+
+	// Un cliente inactivo, con la conexión ya atendida.
+	idle, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idle.Close()
+	decoder := json.NewDecoder(idle)
+	var response shared.Response
+	if err := json.NewEncoder(idle).Encode(shared.Request{Operation: "LOGOUT"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := decoder.Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+
+	shutdown := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		shutdown <- s.Shutdown(ctx)
+	}()
+
+	if err := decoder.Decode(&response); err != nil {
+		t.Fatalf("el cliente inactivo no recibió el aviso: %v", err)
+	}
+	if response.Success || response.Code != shared.CodeShuttingDown {
+		t.Errorf("aviso inesperado: %+v", response)
+	}
+	if err := decoder.Decode(&response); err == nil {
+		t.Error("la conexión inactiva sigue abierta tras el aviso")
+	}
+	if conn, err := net.Dial("tcp", addr); err == nil {
+		conn.Close()
+		t.Error("se aceptó una conexión durante el apagado")
+	}
+
+	select {
+	case err := <-shutdown:
+		t.Fatalf("el apagado no esperó a la operación en curso: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(store.release)
+	if err := <-result; err != nil {
+		t.Errorf("la operación en curso falló: %v", err)
+	}
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestShutdownCancelsRequestsAfterGracePeriod(t *testing.T) {
+	s, _, store, result := startBlockingServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := s.Shutdown(ctx)
+	if err == nil || !strings.Contains(err.Error(), "plazo de apagado vencido con 1 conexiones") {
+		t.Fatalf("se esperaba un apagado forzado: %v", err)
+	}
+	if err := <-store.canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("la operación en curso no se canceló: %v", err)
+	}
+	if err := <-result; err == nil || errors.Is(err, hrclient.ErrShuttingDown) {
+		t.Errorf("se esperaba un error de conexión: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"hr-system/shared"
+	"time"
+)
+
+// Errores de integridad que los stores traducen de sus restricciones, para
+// que EmpleadoCrud responda igual con cualquier implementación.
+var (
+	errEmailDuplicado     = errors.New("email ya existe en el sistema")
+	errReferenciaInvalida = errors.New("ID de cargo, gerente o departamento no válido")
+)
+
+// EmpleadoStore es el acceso a datos de empleados, catálogos, histórico y
+// auditoría. Las reglas de negocio (validación, alcance, versiones y
+// auditoría) quedan en EmpleadoCrud; el store solo guarda y consulta. Cada
+// método recibe el contexto de la petición, que cancela sus consultas.
+type EmpleadoStore interface {
+	// RunInTx ejecuta fn con un store ligado a una transacción. Si el store
+	// ya está en una, fn se ejecuta en ella.
+	RunInTx(ctx context.Context, fn func(tx EmpleadoStore) error) error
+
+	// InsertEmpleado devuelve el ID asignado.
+	InsertEmpleado(ctx context.Context, dto shared.CreateEmpleadoDTO) (int, error)
+	// UpdateEmpleado aplica los campos enviados en dto si el empleado está
+	// activo y en la versión dto.Version, e incrementa la versión. Devuelve
+	// false si no modificó ninguna fila.
+	UpdateEmpleado(ctx context.Context, dto shared.PatchEmpleadoDTO) (bool, error)
+	// DeleteEmpleado hace el borrado lógico y registra el retiro en
+	// histórico, como p_delete_empleado.
+	DeleteEmpleado(ctx context.Context, id int) error
+	// RestoreEmpleado revierte el borrado lógico si el empleado está en la
+	// versión indicada. Devuelve false si no modificó ninguna fila.
+	RestoreEmpleado(ctx context.Context, id, version int) (bool, error)
+	// LockEmpleado devuelve la versión de un empleado activo y lo bloquea
+	// hasta el fin de la transacción; found es false si no está activo.
+	LockEmpleado(ctx context.Context, id int) (version int, found bool, err error)
+	// SnapshotEmpleado devuelve la fila del empleado como JSON para la
+	// auditoría, o nil si no existe.
+	SnapshotEmpleado(ctx context.Context, id int) (json.RawMessage, error)
+	ResumenEmpleado(ctx context.Context, id int) (*shared.UpdateEmpleadoResponseDTO, error)
+	// GetEmpleado devuelve nil si el empleado no existe o está fuera del
+	// alcance de actor.
+	GetEmpleado(ctx context.Context, id int, actor Actor) (*shared.EmpleadoDetailResponseDTO, error)
+	InScope(ctx context.Context, id int, actor Actor) (bool, error)
+	// EachGerente llama a fn con cada empleado activo dentro del alcance de
+	// actor, en orden de ID, sin cargar la lista completa. Si fn falla, la
+	// consulta se interrumpe y devuelve ese error.
+	EachGerente(ctx context.Context, actor Actor, fn func(shared.GerenteDTO) error) error
+
+	ListCargos(ctx context.Context) ([]shared.CargoDTO, error)
+	ListCargosConDatos(ctx context.Context) ([]map[string]any, error)
+	ListDepartamentos(ctx context.Context) ([]shared.DepartamentoDTO, error)
+	ListDepartamentosConDatos(ctx context.Context) ([]map[string]any, error)
+	ListHistorico(ctx context.Context) ([]historicoEntry, error)
+
+	InsertAudit(ctx context.Context, entry auditRecord) error
+	// EachAudit llama a fn con cada registro de auditoría que cumple filter,
+	// del más reciente al más antiguo, como EachGerente.
+	EachAudit(ctx context.Context, filter auditFilter, actor Actor, fn func(shared.AuditEntryDTO) error) error
+}
+
+// historicoEntry es un retiro registrado en la tabla historico.
+type historicoEntry struct {
+	ID          int
+	FechaRetiro string
+	CargoID     int
+	DptoID      int
+}
+
+// auditRecord es un registro nuevo de auditoría; la fecha la pone el store.
+type auditRecord struct {
+	Operador  string
+	Cliente   string
+	Operacion string
+	EmplID    int
+	Antes     json.RawMessage
+	Despues   json.RawMessage
+}
+
+// auditFilter es ListAuditDTO ya validado. Hasta es exclusiva.
+type auditFilter struct {
+	EmplID   *int
+	Operador string
+	Desde    *time.Time
+	Hasta    *time.Time
+	Limit    int
+}
@@ -0,0 +1,674 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStore implementa EmpleadoStore en memoria, para pruebas. Reproduce
+// las restricciones del esquema que afectan a las operaciones: claves
+// foráneas de empleados e histórico, email y nombre de cargo únicos y
+// borrado lógico. Las localizaciones se guardan dentro de cada
+// departamento.
+//
+// Cada escritura trabaja sobre una copia de los datos que reemplaza a la
+// original solo si termina sin error, así que una transacción fallida no
+// deja cambios a medias. Las transacciones se serializan.
+type MemoryStore struct {
+	db *memoryDB
+	tx *memoryData
+}
+
+type memoryDB struct {
+	mu   sync.Mutex
+	data *memoryData
+}
+
+type memoryData struct {
+	cargos        map[int]memoryCargo
+	departamentos map[int]memoryDepartamento
+	empleados     map[int]memoryEmpleado
+	historico     []historicoEntry
+	auditoria     []shared.AuditEntryDTO
+	nextID        map[string]int
+}
+
+type memoryCargo struct {
+	Nombre       string
+	SueldoMinimo float64
+	SueldoMaximo float64
+}
+
+type memoryDepartamento struct {
+	Nombre    string
+	Direccion string
+	Ciudad    string
+}
+
+// memoryEmpleado usa los nombres de columna de empleados para que
+// SnapshotEmpleado produzca el mismo JSON que row_to_json.
+type memoryEmpleado struct {
+	ID            int     `json:"empl_id"`
+	PrimerNombre  string  `json:"empl_primer_nombre"`
+	SegundoNombre *string `json:"empl_segundo_nombre"`
+	Email         string  `json:"empl_email"`
+	FechaNac      string  `json:"empl_fecha_nac"`
+	Sueldo        float64 `json:"empl_sueldo"`
+	Comision      float64 `json:"empl_comision"`
+	CargoID       int     `json:"empl_cargo_id"`
+	GerenteID     *int    `json:"empl_gerente_id"`
+	DptoID        int     `json:"empl_dpto_id"`
+	IsDeleted     bool    `json:"is_deleted"`
+	Version       int     `json:"empl_version"`
+}
+
+func NewMemoryStore() *MemoryStore {
+	data := &memoryData{
+		cargos:        make(map[int]memoryCargo),
+		departamentos: make(map[int]memoryDepartamento),
+		empleados:     make(map[int]memoryEmpleado),
+		nextID:        make(map[string]int),
+	}
+	return &MemoryStore{db: &memoryDB{data: data}}
+}
+
+func (d *memoryData) clone() *memoryData {
+	c := &memoryData{
+		cargos:        make(map[int]memoryCargo, len(d.cargos)),
+		departamentos: make(map[int]memoryDepartamento, len(d.departamentos)),
+		empleados:     make(map[int]memoryEmpleado, len(d.empleados)),
+		historico:     append([]historicoEntry(nil), d.historico...),
+		auditoria:     append([]shared.AuditEntryDTO(nil), d.auditoria...),
+		nextID:        make(map[string]int, len(d.nextID)),
+	}
+	for id, cargo := range d.cargos {
+		c.cargos[id] = cargo
+	}
+	for id, dpto := range d.departamentos {
+		c.departamentos[id] = dpto
+	}
+	for id, emp := range d.empleados {
+		c.empleados[id] = emp
+	}
+	for table, id := range d.nextID {
+		c.nextID[table] = id
+	}
+	return c
+}
+
+// serial imita una columna SERIAL: los IDs no se reutilizan.
+func (d *memoryData) serial(table string) int {
+	d.nextID[table]++
+	return d.nextID[table]
+}
+
+// view ejecuta una lectura sobre los datos vigentes.
+func (s *MemoryStore) view(fn func(d *memoryData) error) error {
+	if s.tx != nil {
+		return fn(s.tx)
+	}
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	return fn(s.db.data)
+}
+
+// update ejecuta una escritura atómica.
+func (s *MemoryStore) update(fn func(d *memoryData) error) error {
+	if s.tx != nil {
+		return fn(s.tx)
+	}
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	work := s.db.data.clone()
+	if err := fn(work); err != nil {
+		return err
+	}
+	s.db.data = work
+	return nil
+}
+
+func (s *MemoryStore) RunInTx(ctx context.Context, fn func(tx EmpleadoStore) error) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+	// Como en los stores SQL, una transacción no empieza si la petición ya
+	// se canceló o excedió su plazo.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	work := s.db.data.clone()
+	if err := fn(&MemoryStore{db: s.db, tx: work}); err != nil {
+		return err
+	}
+	s.db.data = work
+	return nil
+}
+
+// AddCargo agrega un cargo al catálogo y devuelve su ID.
+func (s *MemoryStore) AddCargo(nombre string, sueldoMinimo, sueldoMaximo float64) (int, error) {
+	var id int
+	err := s.update(func(d *memoryData) error {
+		if sueldoMaximo < sueldoMinimo {
+			return errors.New("el sueldo máximo del cargo es menor al mínimo")
+		}
+		for _, cargo := range d.cargos {
+			if cargo.Nombre == nombre {
+				return fmt.Errorf("el cargo '%s' ya existe", nombre)
+			}
+		}
+		id = d.serial("cargos")
+		d.cargos[id] = memoryCargo{Nombre: nombre, SueldoMinimo: sueldoMinimo, SueldoMaximo: sueldoMaximo}
+		return nil
+	})
+	return id, err
+}
+
+// AddDepartamento agrega un departamento con su localización y devuelve su
+// ID.
+func (s *MemoryStore) AddDepartamento(nombre, direccion, ciudad string) (int, error) {
+	var id int
+	err := s.update(func(d *memoryData) error {
+		id = d.serial("departamentos")
+		d.departamentos[id] = memoryDepartamento{Nombre: nombre, Direccion: direccion, Ciudad: ciudad}
+		return nil
+	})
+	return id, err
+}
+
+// checkEmpleado valida las restricciones de la fila emp.
+func (d *memoryData) checkEmpleado(emp memoryEmpleado) error {
+	for id, other := range d.empleados {
+		if id != emp.ID && other.Email == emp.Email {
+			return errEmailDuplicado
+		}
+	}
+	if _, ok := d.cargos[emp.CargoID]; !ok {
+		return errReferenciaInvalida
+	}
+	if _, ok := d.departamentos[emp.DptoID]; !ok {
+		return errReferenciaInvalida
+	}
+	if emp.GerenteID != nil {
+		if _, ok := d.empleados[*emp.GerenteID]; !ok {
+			return errReferenciaInvalida
+		}
+	}
+	return nil
+}
+
+// numeric redondea como las columnas DECIMAL(n,2).
+func numeric(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func (s *MemoryStore) InsertEmpleado(ctx context.Context, dto shared.CreateEmpleadoDTO) (int, error) {
+	var id int
+	err := s.update(func(d *memoryData) error {
+		emp := memoryEmpleado{
+			PrimerNombre:  dto.PrimerNombre,
+			SegundoNombre: dto.SegundoNombre,
+			Email:         dto.Email,
+			FechaNac:      dto.FechaNac,
+			Sueldo:        numeric(dto.Sueldo),
+			Comision:      numeric(dto.Comision),
+			CargoID:       dto.CargoID,
+			GerenteID:     dto.GerenteID,
+			DptoID:        dto.DptoID,
+			Version:       1,
+		}
+		if err := d.checkEmpleado(emp); err != nil {
+			return err
+		}
+		emp.ID = d.serial("empleados")
+		d.empleados[emp.ID] = emp
+		id = emp.ID
+		return nil
+	})
+	return id, err
+}
+
+func (s *MemoryStore) UpdateEmpleado(ctx context.Context, dto shared.PatchEmpleadoDTO) (bool, error) {
+	updated := false
+	err := s.update(func(d *memoryData) error {
+		emp, ok := d.empleados[dto.ID]
+		if !ok || emp.IsDeleted || emp.Version != dto.Version {
+			return nil
+		}
+		if dto.PrimerNombre != nil {
+			emp.PrimerNombre = *dto.PrimerNombre
+		}
+		if dto.SegundoNombre != nil {
+			emp.SegundoNombre = dto.SegundoNombre
+		}
+		if dto.LimpiarSegundoNombre {
+			emp.SegundoNombre = nil
+		}
+		if dto.Email != nil {
+			emp.Email = *dto.Email
+		}
+		if dto.FechaNac != nil {
+			emp.FechaNac = *dto.FechaNac
+		}
+		if dto.Sueldo != nil {
+			emp.Sueldo = numeric(*dto.Sueldo)
+		}
+		if dto.Comision != nil {
+			emp.Comision = numeric(*dto.Comision)
+		}
+		if dto.CargoID != nil {
+			emp.CargoID = *dto.CargoID
+		}
+		if dto.GerenteID != nil {
+			emp.GerenteID = dto.GerenteID
+		}
+		if dto.LimpiarGerente {
+			emp.GerenteID = nil
+		}
+		if dto.DptoID != nil {
+			emp.DptoID = *dto.DptoID
+		}
+		if err := d.checkEmpleado(emp); err != nil {
+			return err
+		}
+		emp.Version++
+		d.empleados[emp.ID] = emp
+		updated = true
+		return nil
+	})
+	return updated, err
+}
+
+// DeleteEmpleado reproduce p_delete_empleado.
+func (s *MemoryStore) DeleteEmpleado(ctx context.Context, id int) error {
+	return s.update(func(d *memoryData) error {
+		emp, ok := d.empleados[id]
+		if !ok || emp.IsDeleted {
+			return errors.New("Empleado no encontrado o ya está eliminado")
+		}
+		d.historico = append(d.historico, historicoEntry{
+			ID:          d.serial("historico"),
+			FechaRetiro: time.Now().Format(shared.FechaLayout),
+			CargoID:     emp.CargoID,
+			DptoID:      emp.DptoID,
+		})
+		emp.IsDeleted = true
+		emp.Version++
+		d.empleados[id] = emp
+		return nil
+	})
+}
+
+func (s *MemoryStore) RestoreEmpleado(ctx context.Context, id, version int) (bool, error) {
+	restored := false
+	err := s.update(func(d *memoryData) error {
+		emp, ok := d.empleados[id]
+		if !ok || !emp.IsDeleted || emp.Version != version {
+			return nil
+		}
+		emp.IsDeleted = false
+		emp.Version++
+		d.empleados[id] = emp
+		restored = true
+		return nil
+	})
+	return restored, err
+}
+
+func (s *MemoryStore) LockEmpleado(ctx context.Context, id int) (int, bool, error) {
+	var version int
+	var found bool
+	err := s.view(func(d *memoryData) error {
+		emp, ok := d.empleados[id]
+		if ok && !emp.IsDeleted {
+			version, found = emp.Version, true
+		}
+		return nil
+	})
+	return version, found, err
+}
+
+func (s *MemoryStore) SnapshotEmpleado(ctx context.Context, id int) (json.RawMessage, error) {
+	var snapshot json.RawMessage
+	err := s.view(func(d *memoryData) error {
+		emp, ok := d.empleados[id]
+		if !ok {
+			return nil
+		}
+		row, err := json.Marshal(emp)
+		if err != nil {
+			return internalError("error leyendo empleado para auditoría: %v", err)
+		}
+		snapshot = row
+		return nil
+	})
+	return snapshot, err
+}
+
+// fechaColumna devuelve una fecha DATE como la entrega lib/pq al leerla en
+// un string.
+func fechaColumna(fecha string) string {
+	t, err := time.Parse(shared.FechaLayout, fecha)
+	if err != nil {
+		return fecha
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// gerenteNombre arma el nombre del gerente como el CONCAT de las consultas:
+// primer nombre, un espacio y el segundo nombre si lo tiene.
+func (d *memoryData) gerenteNombre(gerenteID *int) *string {
+	if gerenteID == nil {
+		return nil
+	}
+	g, ok := d.empleados[*gerenteID]
+	if !ok {
+		return nil
+	}
+	nombre := g.PrimerNombre + " "
+	if g.SegundoNombre != nil {
+		nombre += *g.SegundoNombre
+	}
+	return &nombre
+}
+
+func (s *MemoryStore) ResumenEmpleado(ctx context.Context, id int) (*shared.UpdateEmpleadoResponseDTO, error) {
+	var response *shared.UpdateEmpleadoResponseDTO
+	err := s.view(func(d *memoryData) error {
+		emp, ok := d.empleados[id]
+		if !ok {
+			return errors.New("empleado no encontrado")
+		}
+		cargo := d.cargos[emp.CargoID]
+		dpto := d.departamentos[emp.DptoID]
+		response = &shared.UpdateEmpleadoResponseDTO{
+			ID:                 emp.ID,
+			PrimerNombre:       emp.PrimerNombre,
+			SegundoNombre:      emp.SegundoNombre,
+			FechaNac:           fechaColumna(emp.FechaNac),
+			CargoNombre:        cargo.Nombre,
+			DepartamentoNombre: dpto.Nombre,
+			GerenteNombre:      d.gerenteNombre(emp.GerenteID),
+			Sueldo:             emp.Sueldo,
+			Comision:           emp.Comision,
+			Direccion:          dpto.Direccion,
+			Ciudad:             dpto.Ciudad,
+			Version:            emp.Version,
+		}
+		return nil
+	})
+	return response, err
+}
+
+func (s *MemoryStore) GetEmpleado(ctx context.Context, id int, actor Actor) (*shared.EmpleadoDetailResponseDTO, error) {
+	var detail *shared.EmpleadoDetailResponseDTO
+	err := s.view(func(d *memoryData) error {
+		emp, ok := d.empleados[id]
+		if !ok || !d.inScope(id, actor) {
+			return nil
+		}
+		cargo := d.cargos[emp.CargoID]
+		dpto := d.departamentos[emp.DptoID]
+		detail = &shared.EmpleadoDetailResponseDTO{
+			ID:                 emp.ID,
+			PrimerNombre:       emp.PrimerNombre,
+			SegundoNombre:      emp.SegundoNombre,
+			Email:              emp.Email,
+			FechaNac:           fechaColumna(emp.FechaNac),
+			Sueldo:             emp.Sueldo,
+			Comision:           emp.Comision,
+			CargoID:            emp.CargoID,
+			CargoNombre:        cargo.Nombre,
+			GerenteID:          emp.GerenteID,
+			GerenteNombre:      d.gerenteNombre(emp.GerenteID),
+			DptoID:             emp.DptoID,
+			DepartamentoNombre: dpto.Nombre,
+			Direccion:          dpto.Direccion,
+			Ciudad:             dpto.Ciudad,
+			IsDeleted:          emp.IsDeleted,
+			Version:            emp.Version,
+		}
+		return nil
+	})
+	return detail, err
+}
+
+// inScope reproduce scopeCondition sobre un empleado existente.
+func (d *memoryData) inScope(id int, actor Actor) bool {
+	if _, ok := d.empleados[id]; !ok {
+		return false
+	}
+	if actor.Alcance == "" || actor.Alcance == shared.AlcanceTodos {
+		return true
+	}
+	if actor.EmplID == nil {
+		return false
+	}
+	switch actor.Alcance {
+	case shared.AlcancePropio:
+		return id == *actor.EmplID
+	case shared.AlcanceDepartamento:
+		return d.empleadosACargo(*actor.EmplID)[id]
+	default:
+		return false
+	}
+}
+
+// empleadosACargo reproduce f_empleados_a_cargo: el departamento del jefe,
+// toda su línea de reporte y él mismo.
+func (d *memoryData) empleadosACargo(jefeID int) map[int]bool {
+	visibles := map[int]bool{jefeID: true}
+	if jefe, ok := d.empleados[jefeID]; ok {
+		for id, emp := range d.empleados {
+			if emp.DptoID == jefe.DptoID {
+				visibles[id] = true
+			}
+		}
+	}
+	subordinados := map[int]bool{}
+	pendientes := []int{jefeID}
+	for len(pendientes) > 0 {
+		gerente := pendientes[0]
+		pendientes = pendientes[1:]
+		for id, emp := range d.empleados {
+			if emp.GerenteID != nil && *emp.GerenteID == gerente && !subordinados[id] {
+				subordinados[id] = true
+				visibles[id] = true
+				pendientes = append(pendientes, id)
+			}
+		}
+	}
+	return visibles
+}
+
+func (s *MemoryStore) InScope(ctx context.Context, id int, actor Actor) (bool, error) {
+	var ok bool
+	err := s.view(func(d *memoryData) error {
+		ok = d.inScope(id, actor)
+		return nil
+	})
+	return ok, err
+}
+
+func sortedIDs[T any](rows map[int]T) []int {
+	ids := make([]int, 0, len(rows))
+	for id := range rows {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// EachGerente copia la lista antes de recorrerla, para no llamar a fn con
+// los datos bloqueados.
+func (s *MemoryStore) EachGerente(ctx context.Context, actor Actor, fn func(shared.GerenteDTO) error) error {
+	var gerentes []shared.GerenteDTO
+	err := s.view(func(d *memoryData) error {
+		for _, id := range sortedIDs(d.empleados) {
+			emp := d.empleados[id]
+			if emp.IsDeleted || !d.inScope(id, actor) {
+				continue
+			}
+			gerentes = append(gerentes, shared.GerenteDTO{ID: id, Nombre: *d.gerenteNombre(&id)})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, gerente := range gerentes {
+		if err := fn(gerente); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) ListCargos(ctx context.Context) ([]shared.CargoDTO, error) {
+	var cargos []shared.CargoDTO
+	err := s.view(func(d *memoryData) error {
+		for _, id := range sortedIDs(d.cargos) {
+			cargos = append(cargos, shared.CargoDTO{ID: id, Nombre: d.cargos[id].Nombre})
+		}
+		return nil
+	})
+	return cargos, err
+}
+
+func (s *MemoryStore) ListCargosConDatos(ctx context.Context) ([]map[string]any, error) {
+	var cargos []map[string]any
+	err := s.view(func(d *memoryData) error {
+		type fila struct {
+			cargoID           int
+			direccion, ciudad string
+		}
+		vistas := make(map[fila]bool)
+		var filas []fila
+		for _, emp := range d.empleados {
+			dpto := d.departamentos[emp.DptoID]
+			f := fila{emp.CargoID, dpto.Direccion, dpto.Ciudad}
+			if !vistas[f] {
+				vistas[f] = true
+				filas = append(filas, f)
+			}
+		}
+		sort.Slice(filas, func(i, j int) bool {
+			if filas[i].cargoID != filas[j].cargoID {
+				return filas[i].cargoID < filas[j].cargoID
+			}
+			if filas[i].direccion != filas[j].direccion {
+				return filas[i].direccion < filas[j].direccion
+			}
+			return filas[i].ciudad < filas[j].ciudad
+		})
+		for _, f := range filas {
+			cargos = append(cargos, map[string]any{
+				"cargo_id":     f.cargoID,
+				"cargo_nombre": d.cargos[f.cargoID].Nombre,
+				"direccion":    f.direccion,
+				"ciudad":       f.ciudad,
+			})
+		}
+		return nil
+	})
+	return cargos, err
+}
+
+func (s *MemoryStore) ListDepartamentos(ctx context.Context) ([]shared.DepartamentoDTO, error) {
+	var departamentos []shared.DepartamentoDTO
+	err := s.view(func(d *memoryData) error {
+		for _, id := range sortedIDs(d.departamentos) {
+			departamentos = append(departamentos, shared.DepartamentoDTO{ID: id, Nombre: d.departamentos[id].Nombre})
+		}
+		return nil
+	})
+	return departamentos, err
+}
+
+func (s *MemoryStore) ListDepartamentosConDatos(ctx context.Context) ([]map[string]any, error) {
+	var departamentos []map[string]any
+	err := s.view(func(d *memoryData) error {
+		for _, id := range sortedIDs(d.departamentos) {
+			dpto := d.departamentos[id]
+			departamentos = append(departamentos, map[string]any{
+				"dpto_id":     id,
+				"dpto_nombre": dpto.Nombre,
+				"direccion":   dpto.Direccion,
+				"ciudad":      dpto.Ciudad,
+			})
+		}
+		return nil
+	})
+	return departamentos, err
+}
+
+func (s *MemoryStore) ListHistorico(ctx context.Context) ([]historicoEntry, error) {
+	var entries []historicoEntry
+	err := s.view(func(d *memoryData) error {
+		entries = append(entries, d.historico...)
+		return nil
+	})
+	return entries, err
+}
+
+func (s *MemoryStore) InsertAudit(ctx context.Context, entry auditRecord) error {
+	return s.update(func(d *memoryData) error {
+		emplID := entry.EmplID
+		d.auditoria = append(d.auditoria, shared.AuditEntryDTO{
+			ID:        int64(d.serial("auditoria")),
+			Fecha:     time.Now().Format(time.RFC3339),
+			Operador:  entry.Operador,
+			Cliente:   entry.Cliente,
+			Operacion: entry.Operacion,
+			EmplID:    &emplID,
+			Antes:     entry.Antes,
+			Despues:   entry.Despues,
+		})
+		return nil
+	})
+}
+
+// EachAudit copia los registros antes de recorrerlos, como EachGerente.
+func (s *MemoryStore) EachAudit(ctx context.Context, filter auditFilter, actor Actor, fn func(shared.AuditEntryDTO) error) error {
+	var entries []shared.AuditEntryDTO
+	err := s.view(func(d *memoryData) error {
+		for i := len(d.auditoria) - 1; i >= 0 && len(entries) < filter.Limit; i-- {
+			entry := d.auditoria[i]
+			if filter.EmplID != nil && *entry.EmplID != *filter.EmplID {
+				continue
+			}
+			if filter.Operador != "" && entry.Operador != filter.Operador {
+				continue
+			}
+			fecha, _ := time.Parse(time.RFC3339, entry.Fecha)
+			if filter.Desde != nil && fecha.Before(*filter.Desde) {
+				continue
+			}
+			if filter.Hasta != nil && !fecha.Before(*filter.Hasta) {
+				continue
+			}
+			if !d.inScope(*entry.EmplID, actor) {
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"hr-system/shared"
+	"testing"
+)
+
+func TestMemoryStoreConstraints(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	cargo, err := store.AddCargo("Analista", 1000, 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AddCargo("Analista", 1000, 5000); err == nil {
+		t.Error("se aceptó un nombre de cargo repetido")
+	}
+	if _, err := store.AddCargo("Invertido", 5000, 1000); err == nil {
+		t.Error("se aceptó un sueldo máximo menor al mínimo")
+	}
+	dpto, err := store.AddDepartamento("Ventas", "Av. Principal 100", "Lima")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dto := shared.CreateEmpleadoDTO{
+		PrimerNombre: "Ana", Email: "ana@empresa.com", FechaNac: "1990-05-15",
+		Sueldo: 2500, CargoID: cargo, DptoID: dpto,
+	}
+	id, err := store.InsertEmpleado(ctx, dto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.InsertEmpleado(ctx, dto); !errors.Is(err, errEmailDuplicado) {
+		t.Errorf("email repetido: %v", err)
+	}
+	for name, mutate := range map[string]func(*shared.CreateEmpleadoDTO){
+		"cargo":        func(d *shared.CreateEmpleadoDTO) { d.CargoID = 99 },
+		"departamento": func(d *shared.CreateEmpleadoDTO) { d.DptoID = 99 },
+		"gerente":      func(d *shared.CreateEmpleadoDTO) { g := 99; d.GerenteID = &g },
+	} {
+		bad := dto
+		bad.Email = name + "@empresa.com"
+		mutate(&bad)
+		if _, err := store.InsertEmpleado(ctx, bad); !errors.Is(err, errReferenciaInvalida) {
+			t.Errorf("%s inexistente: %v", name, err)
+		}
+	}
+
+	if err := store.DeleteEmpleado(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DeleteEmpleado(ctx, id); err == nil {
+		t.Error("se eliminó dos veces el mismo empleado")
+	}
+	if _, found, _ := store.LockEmpleado(ctx, id); found {
+		t.Error("LockEmpleado encontró un empleado eliminado")
+	}
+	sueldo := 3000.0
+	if updated, _ := store.UpdateEmpleado(ctx, shared.PatchEmpleadoDTO{ID: id, Sueldo: &sueldo, Version: 2}); updated {
+		t.Error("se actualizó un empleado eliminado")
+	}
+	if restored, _ := store.RestoreEmpleado(ctx, id, 1); restored {
+		t.Error("se restauró con una versión vieja")
+	}
+	if restored, _ := store.RestoreEmpleado(ctx, id, 2); !restored {
+		t.Error("no se restauró con la versión vigente")
+	}
+}
+
+func TestMemoryStoreRollsBackFailedTransactions(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	cargo, _ := store.AddCargo("Analista", 1000, 5000)
+	dpto, _ := store.AddDepartamento("Ventas", "Av. Principal 100", "Lima")
+	dto := shared.CreateEmpleadoDTO{
+		PrimerNombre: "Ana", Email: "ana@empresa.com", FechaNac: "1990-05-15",
+		Sueldo: 2500, CargoID: cargo, DptoID: dpto,
+	}
+	errAbort := errors.New("abortar")
+	err := store.RunInTx(ctx, func(tx EmpleadoStore) error {
+		id, err := tx.InsertEmpleado(ctx, dto)
+		if err != nil {
+			return err
+		}
+		if err := tx.DeleteEmpleado(ctx, id); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("RunInTx: %v", err)
+	}
+	historico, _ := store.ListHistorico(ctx)
+	gerentes, _ := NewEmpleadoCrud(store).ListGerentes(ctx)
+	if len(historico) != 0 || len(gerentes) != 0 {
+		t.Errorf("la transacción revertida dejó datos: %+v %+v", historico, gerentes)
+	}
+	if _, err := store.InsertEmpleado(ctx, dto); err != nil {
+		t.Errorf("el email de la transacción revertida quedó ocupado: %v", err)
+	}
+}
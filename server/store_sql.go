@@ -0,0 +1,601 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"strings"
+	"time"
+)
+
+// dbtx es la parte común de *sql.DB y *sql.Tx que usa SQLStore, para
+// que las mismas operaciones puedan ejecutarse dentro de una transacción.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// SQLStore implementa EmpleadoStore sobre el esquema de migrations, en
+// Postgres o en SQLite. Las consultas son comunes salvo donde el dialecto
+// obliga a otra cosa: bloqueos de fila, row_to_json, f_empleados_a_cargo y
+// p_delete_empleado, que en SQLite se resuelven con SQL estándar y Go.
+type SQLStore struct {
+	db      *sql.DB
+	q       dbtx
+	tx      *sql.Tx
+	dialect dialect
+}
+
+func NewPostgresStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db, q: db, dialect: dialectPostgres}
+}
+
+// NewSQLiteStore espera una base abierta con sqliteDSN.
+func NewSQLiteStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db, q: db, dialect: dialectSQLite}
+}
+
+func (s *SQLStore) RunInTx(ctx context.Context, fn func(tx EmpleadoStore) error) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return internalError("error iniciando transacción: %v", err)
+	}
+	if err := fn(&SQLStore{db: s.db, q: tx, tx: tx, dialect: s.dialect}); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return internalError("error confirmando transacción: %v", err)
+	}
+	return nil
+}
+
+// writeError traduce las violaciones de restricciones de empleados.
+func writeError(action string, err error) error {
+	if isUniqueViolation(err) {
+		return errEmailDuplicado
+	}
+	if isForeignKeyViolation(err) {
+		return errReferenciaInvalida
+	}
+	return internalError("error %s empleado: %v", action, err)
+}
+
+func (s *SQLStore) InsertEmpleado(ctx context.Context, dto shared.CreateEmpleadoDTO) (int, error) {
+	query := `
+		INSERT INTO empleados (empl_primer_nombre, empl_segundo_nombre, empl_email,
+		empl_fecha_nac, empl_sueldo, empl_comision, empl_cargo_id, empl_gerente_id, empl_dpto_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING empl_id`
+	var newID int
+	err := s.q.QueryRowContext(ctx, query, dto.PrimerNombre, dto.SegundoNombre, dto.Email,
+		dto.FechaNac, s.decimal(dto.Sueldo), s.decimal(dto.Comision), dto.CargoID, dto.GerenteID,
+		dto.DptoID).Scan(&newID)
+	if err != nil {
+		return 0, writeError("insertando", err)
+	}
+	return newID, nil
+}
+
+func (s *SQLStore) UpdateEmpleado(ctx context.Context, dto shared.PatchEmpleadoDTO) (bool, error) {
+	var sets []string
+	var args []any
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s=$%d", column, len(args)))
+	}
+	if dto.PrimerNombre != nil {
+		set("empl_primer_nombre", *dto.PrimerNombre)
+	}
+	if dto.SegundoNombre != nil {
+		set("empl_segundo_nombre", *dto.SegundoNombre)
+	}
+	if dto.LimpiarSegundoNombre {
+		set("empl_segundo_nombre", nil)
+	}
+	if dto.Email != nil {
+		set("empl_email", *dto.Email)
+	}
+	if dto.FechaNac != nil {
+		set("empl_fecha_nac", *dto.FechaNac)
+	}
+	if dto.Sueldo != nil {
+		set("empl_sueldo", s.decimal(*dto.Sueldo))
+	}
+	if dto.Comision != nil {
+		set("empl_comision", s.decimal(*dto.Comision))
+	}
+	if dto.CargoID != nil {
+		set("empl_cargo_id", *dto.CargoID)
+	}
+	if dto.GerenteID != nil {
+		set("empl_gerente_id", *dto.GerenteID)
+	}
+	if dto.LimpiarGerente {
+		set("empl_gerente_id", nil)
+	}
+	if dto.DptoID != nil {
+		set("empl_dpto_id", *dto.DptoID)
+	}
+	sets = append(sets, "empl_version=empl_version+1")
+	args = append(args, dto.ID, dto.Version)
+	query := fmt.Sprintf(`UPDATE empleados SET %s WHERE empl_id=$%d AND is_deleted=false AND empl_version=$%d`,
+		strings.Join(sets, ", "), len(args)-1, len(args))
+	result, err := s.q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, writeError("actualizando", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+// decimal redondea como las columnas DECIMAL(n,2) de Postgres; SQLite
+// guardaría el valor tal cual.
+func (s *SQLStore) decimal(value float64) float64 {
+	if s.dialect == dialectSQLite {
+		return numeric(value)
+	}
+	return value
+}
+
+func (s *SQLStore) DeleteEmpleado(ctx context.Context, id int) error {
+	if s.dialect == dialectSQLite {
+		return s.RunInTx(ctx, func(tx EmpleadoStore) error {
+			return tx.(*SQLStore).deleteEmpleado(ctx, id)
+		})
+	}
+	var success bool
+	var message string
+	err := s.q.QueryRowContext(ctx, `SELECT success, message FROM p_delete_empleado($1)`, id).Scan(&success, &message)
+	if err != nil {
+		return internalError("error ejecutando procedimiento almacenado: %v", err)
+	}
+	if !success {
+		return errors.New(message)
+	}
+	return nil
+}
+
+// deleteEmpleado reproduce p_delete_empleado para SQLite, que no tiene
+// procedimientos almacenados. Debe ejecutarse en una transacción.
+func (s *SQLStore) deleteEmpleado(ctx context.Context, id int) error {
+	var cargoID, dptoID int
+	err := s.q.QueryRowContext(ctx, `SELECT empl_cargo_id, empl_dpto_id FROM empleados WHERE empl_id=$1 AND is_deleted=false`,
+		id).Scan(&cargoID, &dptoID)
+	if err == sql.ErrNoRows {
+		return errors.New("Empleado no encontrado o ya está eliminado")
+	}
+	if err != nil {
+		return internalError("Error eliminando empleado: %v", err)
+	}
+	_, err = s.q.ExecContext(ctx, `INSERT INTO historico (emphist_cargo_id, emphist_dpto_id) VALUES ($1, $2)`, cargoID, dptoID)
+	if err != nil {
+		return internalError("Error eliminando empleado: %v", err)
+	}
+	_, err = s.q.ExecContext(ctx, `UPDATE empleados SET is_deleted=true, empl_version=empl_version+1 WHERE empl_id=$1`, id)
+	if err != nil {
+		return internalError("Error eliminando empleado: %v", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) RestoreEmpleado(ctx context.Context, id, version int) (bool, error) {
+	result, err := s.q.ExecContext(ctx, `
+		UPDATE empleados SET is_deleted=false, empl_version=empl_version+1
+		WHERE empl_id=$1 AND is_deleted=true AND empl_version=$2`, id, version)
+	if err != nil {
+		return false, internalError("error restaurando empleado: %v", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+func (s *SQLStore) LockEmpleado(ctx context.Context, id int) (int, bool, error) {
+	var version int
+	err := s.q.QueryRowContext(ctx, `SELECT empl_version FROM empleados WHERE empl_id=$1 AND is_deleted=false`+
+		s.dialect.forUpdate(), id).Scan(&version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, internalError("error consultando empleado: %v", err)
+	}
+	return version, true, nil
+}
+
+// SnapshotEmpleado bloquea la fila hasta el fin de la transacción para que
+// el "antes" de la auditoría no cambie antes de escribir.
+func (s *SQLStore) SnapshotEmpleado(ctx context.Context, id int) (json.RawMessage, error) {
+	query := `SELECT row_to_json(e) FROM empleados e WHERE e.empl_id=$1 FOR UPDATE`
+	if s.dialect == dialectSQLite {
+		query = sqliteSnapshotQuery
+	}
+	var row []byte
+	err := s.q.QueryRowContext(ctx, query, id).Scan(&row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, internalError("error leyendo empleado para auditoría: %v", err)
+	}
+	return json.RawMessage(row), nil
+}
+
+// sqliteSnapshotQuery arma en SQLite el mismo JSON que row_to_json(e) en
+// Postgres, con los nombres de columna en minúsculas y is_deleted booleano.
+const sqliteSnapshotQuery = `
+	SELECT json_object(
+	       'empl_id', empl_id, 'empl_primer_nombre', empl_primer_nombre,
+	       'empl_segundo_nombre', empl_segundo_nombre, 'empl_email', empl_email,
+	       'empl_fecha_nac', empl_fecha_nac, 'empl_sueldo', empl_sueldo,
+	       'empl_comision', empl_comision, 'empl_cargo_id', empl_cargo_id,
+	       'empl_gerente_id', empl_gerente_id, 'empl_dpto_id', empl_dpto_id,
+	       'is_deleted', json(CASE WHEN is_deleted THEN 'true' ELSE 'false' END),
+	       'empl_version', empl_version)
+	FROM empleados WHERE empl_id=$1`
+
+const empleadoResumenQuery = `
+	SELECT e.empl_id, e.empl_primer_nombre, e.empl_segundo_nombre, e.empl_fecha_nac,
+	       c.cargo_nombre,
+	       d.dpto_nombre,
+	       CASE WHEN g.empl_id IS NOT NULL
+	            THEN CONCAT(g.empl_primer_nombre, ' ', COALESCE(g.empl_segundo_nombre, ''))
+	            ELSE NULL
+	       END as gerente_nombre,
+	       e.empl_sueldo, e.empl_comision,
+	       l.localiz_direccion,
+	       ci.ciud_nombre,
+	       e.empl_version
+	FROM empleados e
+	INNER JOIN cargos c ON e.empl_cargo_id = c.cargo_id
+	INNER JOIN departamentos d ON e.empl_dpto_id = d.dpto_id
+	INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
+	INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
+	LEFT JOIN empleados g ON e.empl_gerente_id = g.empl_id
+	WHERE e.empl_id = $1`
+
+func (s *SQLStore) ResumenEmpleado(ctx context.Context, id int) (*shared.UpdateEmpleadoResponseDTO, error) {
+	var response shared.UpdateEmpleadoResponseDTO
+	err := s.q.QueryRowContext(ctx, empleadoResumenQuery, id).Scan(
+		&response.ID, &response.PrimerNombre, &response.SegundoNombre, &response.FechaNac,
+		&response.CargoNombre, &response.DepartamentoNombre, &response.GerenteNombre,
+		&response.Sueldo, &response.Comision, &response.Direccion, &response.Ciudad,
+		&response.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (s *SQLStore) GetEmpleado(ctx context.Context, id int, actor Actor) (*shared.EmpleadoDetailResponseDTO, error) {
+	query := `
+		SELECT e.empl_id, e.empl_primer_nombre, e.empl_segundo_nombre, e.empl_email,
+		       e.empl_fecha_nac, e.empl_sueldo, e.empl_comision,
+		       e.empl_cargo_id, c.cargo_nombre,
+		       e.empl_gerente_id,
+		       CASE WHEN g.empl_id IS NOT NULL
+		            THEN CONCAT(g.empl_primer_nombre, ' ', COALESCE(g.empl_segundo_nombre, ''))
+		            ELSE NULL
+		       END as gerente_nombre,
+		       e.empl_dpto_id, d.dpto_nombre,
+		       l.localiz_direccion,
+		       ci.ciud_nombre,
+		       e.is_deleted,
+		       e.empl_version
+		FROM empleados e
+		INNER JOIN cargos c ON e.empl_cargo_id = c.cargo_id
+		INNER JOIN departamentos d ON e.empl_dpto_id = d.dpto_id
+		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
+		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
+		LEFT JOIN empleados g ON e.empl_gerente_id = g.empl_id
+		WHERE e.empl_id=$1`
+	scope, args := s.scopeCondition(actor, "e.empl_id", []any{id})
+	query += " AND " + scope
+	var emp shared.EmpleadoDetailResponseDTO
+	err := s.q.QueryRowContext(ctx, query, args...).Scan(
+		&emp.ID, &emp.PrimerNombre, &emp.SegundoNombre, &emp.Email,
+		&emp.FechaNac, &emp.Sueldo, &emp.Comision,
+		&emp.CargoID, &emp.CargoNombre, &emp.GerenteID, &emp.GerenteNombre,
+		&emp.DptoID, &emp.DepartamentoNombre,
+		&emp.Direccion, &emp.Ciudad,
+		&emp.IsDeleted, &emp.Version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, internalError("error consultando empleado: %v", err)
+	}
+	return &emp, nil
+}
+
+// scopeCondition devuelve una condición SQL que limita column (un ID de
+// empleado) al alcance de actor, agregando a args los parámetros que usa.
+func (s *SQLStore) scopeCondition(actor Actor, column string, args []any) (string, []any) {
+	if actor.Alcance == "" || actor.Alcance == shared.AlcanceTodos {
+		return "TRUE", args
+	}
+	if actor.EmplID == nil {
+		return "FALSE", args
+	}
+	args = append(args, *actor.EmplID)
+	switch actor.Alcance {
+	case shared.AlcancePropio:
+		return fmt.Sprintf("%s = $%d", column, len(args)), args
+	case shared.AlcanceDepartamento:
+		if s.dialect == dialectSQLite {
+			return fmt.Sprintf("%s IN (%s)", column, strings.ReplaceAll(sqliteEmpleadosACargo, "$n",
+				fmt.Sprintf("$%d", len(args)))), args
+		}
+		return fmt.Sprintf("%s IN (SELECT f_empleados_a_cargo($%d))", column, len(args)), args
+	default:
+		return "FALSE", args[:len(args)-1]
+	}
+}
+
+// sqliteEmpleadosACargo es f_empleados_a_cargo como subconsulta; $n es el
+// empleado a cargo.
+const sqliteEmpleadosACargo = `
+	WITH RECURSIVE subordinados(empl_id) AS (
+	    SELECT empl_id FROM empleados WHERE empl_gerente_id = $n
+	    UNION
+	    SELECT sub.empl_id
+	    FROM empleados sub
+	    INNER JOIN subordinados s ON sub.empl_gerente_id = s.empl_id
+	)
+	SELECT empl_id FROM empleados
+	WHERE empl_dpto_id = (SELECT empl_dpto_id FROM empleados WHERE empl_id = $n)
+	UNION
+	SELECT empl_id FROM subordinados
+	UNION
+	SELECT $n`
+
+func (s *SQLStore) InScope(ctx context.Context, id int, actor Actor) (bool, error) {
+	condition, args := s.scopeCondition(actor, "empl_id", []any{id})
+	query := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM empleados WHERE empl_id=$1 AND %s)`, condition)
+	var exists bool
+	if err := s.q.QueryRowContext(ctx, query, args...).Scan(&exists); err != nil {
+		return false, internalError("error verificando alcance: %v", err)
+	}
+	return exists, nil
+}
+
+func (s *SQLStore) EachGerente(ctx context.Context, actor Actor, fn func(shared.GerenteDTO) error) error {
+	query := `
+		SELECT empl_id, CONCAT(empl_primer_nombre, ' ', COALESCE(empl_segundo_nombre, '')) as nombre_completo
+		FROM empleados
+		WHERE is_deleted=false AND %s
+		ORDER BY empl_id`
+	scope, args := s.scopeCondition(actor, "empl_id", nil)
+	rows, err := s.q.QueryContext(ctx, fmt.Sprintf(query, scope), args...)
+	if err != nil {
+		return internalError("error consultando gerentes: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var gerente shared.GerenteDTO
+		err := rows.Scan(&gerente.ID, &gerente.Nombre)
+		if err != nil {
+			return internalError("error escaneando gerente: %v", err)
+		}
+		if err := fn(gerente); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (s *SQLStore) ListCargos(ctx context.Context) ([]shared.CargoDTO, error) {
+	query := `SELECT cargo_id, cargo_nombre FROM cargos ORDER BY cargo_id`
+	rows, err := s.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, internalError("error consultando cargos: %v", err)
+	}
+	defer rows.Close()
+	var cargos []shared.CargoDTO
+	for rows.Next() {
+		var cargo shared.CargoDTO
+		err := rows.Scan(&cargo.ID, &cargo.Nombre)
+		if err != nil {
+			return nil, internalError("error escaneando cargo: %v", err)
+		}
+		cargos = append(cargos, cargo)
+	}
+	return cargos, nil
+}
+
+func (s *SQLStore) ListCargosConDatos(ctx context.Context) ([]map[string]any, error) {
+	query := `
+		SELECT DISTINCT c.cargo_id, c.cargo_nombre, l.localiz_direccion, ci.ciud_nombre
+		FROM cargos c
+		INNER JOIN empleados e ON c.cargo_id = e.empl_cargo_id
+		INNER JOIN departamentos d ON e.empl_dpto_id = d.dpto_id
+		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
+		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
+		ORDER BY c.cargo_id`
+
+	rows, err := s.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, internalError("error consultando cargos con datos: %v", err)
+	}
+	defer rows.Close()
+
+	var cargos []map[string]any
+	for rows.Next() {
+		var cargoID int
+		var cargoNombre, direccion, ciudad string
+		err := rows.Scan(&cargoID, &cargoNombre, &direccion, &ciudad)
+		if err != nil {
+			return nil, internalError("error escaneando cargo con datos: %v", err)
+		}
+		cargo := map[string]any{
+			"cargo_id":     cargoID,
+			"cargo_nombre": cargoNombre,
+			"direccion":    direccion,
+			"ciudad":       ciudad,
+		}
+		cargos = append(cargos, cargo)
+	}
+	return cargos, nil
+}
+
+func (s *SQLStore) ListDepartamentos(ctx context.Context) ([]shared.DepartamentoDTO, error) {
+	query := `SELECT dpto_id, dpto_nombre FROM departamentos ORDER BY dpto_id`
+	rows, err := s.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, internalError("error consultando departamentos: %v", err)
+	}
+	defer rows.Close()
+	var departamentos []shared.DepartamentoDTO
+	for rows.Next() {
+		var dpto shared.DepartamentoDTO
+		err := rows.Scan(&dpto.ID, &dpto.Nombre)
+		if err != nil {
+			return nil, internalError("error escaneando departamento: %v", err)
+		}
+		departamentos = append(departamentos, dpto)
+	}
+	return departamentos, nil
+}
+
+func (s *SQLStore) ListDepartamentosConDatos(ctx context.Context) ([]map[string]any, error) {
+	query := `
+		SELECT d.dpto_id, d.dpto_nombre, l.localiz_direccion, ci.ciud_nombre
+		FROM departamentos d
+		INNER JOIN localizaciones l ON d.dpto_localiz_ID = l.localiz_ID
+		INNER JOIN ciudades ci ON l.localiz_ciudad_ID = ci.ciud_ID
+		ORDER BY d.dpto_id`
+
+	rows, err := s.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, internalError("error consultando departamentos con datos: %v", err)
+	}
+	defer rows.Close()
+
+	var departamentos []map[string]any
+	for rows.Next() {
+		var dptoID int
+		var dptoNombre, direccion, ciudad string
+		err := rows.Scan(&dptoID, &dptoNombre, &direccion, &ciudad)
+		if err != nil {
+			return nil, internalError("error escaneando departamento con datos: %v", err)
+		}
+		dpto := map[string]any{
+			"dpto_id":     dptoID,
+			"dpto_nombre": dptoNombre,
+			"direccion":   direccion,
+			"ciudad":      ciudad,
+		}
+		departamentos = append(departamentos, dpto)
+	}
+	return departamentos, nil
+}
+
+func (s *SQLStore) ListHistorico(ctx context.Context) ([]historicoEntry, error) {
+	rows, err := s.q.QueryContext(ctx, `
+		SELECT emphist_ID, emphist_fecha_retiro, emphist_cargo_ID, emphist_dpto_ID
+		FROM historico
+		ORDER BY emphist_ID`)
+	if err != nil {
+		return nil, internalError("error consultando histórico: %v", err)
+	}
+	defer rows.Close()
+	var entries []historicoEntry
+	for rows.Next() {
+		var entry historicoEntry
+		var fecha time.Time
+		if err := rows.Scan(&entry.ID, &fecha, &entry.CargoID, &entry.DptoID); err != nil {
+			return nil, internalError("error escaneando histórico: %v", err)
+		}
+		entry.FechaRetiro = fecha.Format(shared.FechaLayout)
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func (s *SQLStore) InsertAudit(ctx context.Context, entry auditRecord) error {
+	_, err := s.q.ExecContext(ctx, `
+		INSERT INTO auditoria (audit_operador, audit_cliente, audit_operacion,
+		audit_empl_ID, audit_antes, audit_despues)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		entry.Operador, entry.Cliente, entry.Operacion, entry.EmplID,
+		nullableJSON(entry.Antes), nullableJSON(entry.Despues))
+	if err != nil {
+		return internalError("error registrando auditoría: %v", err)
+	}
+	return nil
+}
+
+func nullableJSON(data json.RawMessage) any {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+func (s *SQLStore) EachAudit(ctx context.Context, filter auditFilter, actor Actor, fn func(shared.AuditEntryDTO) error) error {
+	var conditions []string
+	var args []any
+	where := func(condition string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.EmplID != nil {
+		where("audit_empl_ID = $%d", *filter.EmplID)
+	}
+	if filter.Operador != "" {
+		where("audit_operador = $%d", filter.Operador)
+	}
+	if filter.Desde != nil {
+		where("audit_fecha >= $%d", s.dialect.timeArg(*filter.Desde))
+	}
+	if filter.Hasta != nil {
+		where("audit_fecha < $%d", s.dialect.timeArg(*filter.Hasta))
+	}
+	if scope, scopeArgs := s.scopeCondition(actor, "e.empl_id", args); scope != "TRUE" {
+		args = scopeArgs
+		conditions = append(conditions,
+			fmt.Sprintf("audit_empl_ID IN (SELECT e.empl_id FROM empleados e WHERE %s)", scope))
+	}
+	query := `
+		SELECT audit_ID, audit_fecha, audit_operador, audit_cliente, audit_operacion,
+		       audit_empl_ID, audit_antes, audit_despues
+		FROM auditoria`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY audit_ID DESC LIMIT $%d", len(args))
+	rows, err := s.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return internalError("error consultando auditoría: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var entry shared.AuditEntryDTO
+		var fecha time.Time
+		var antes, despues []byte
+		err := rows.Scan(&entry.ID, &fecha, &entry.Operador, &entry.Cliente, &entry.Operacion,
+			&entry.EmplID, &antes, &despues)
+		if err != nil {
+			return internalError("error escaneando auditoría: %v", err)
+		}
+		entry.Fecha = fecha.Format(time.RFC3339)
+		if antes != nil {
+			entry.Antes = json.RawMessage(antes)
+		}
+		if despues != nil {
+			entry.Despues = json.RawMessage(despues)
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
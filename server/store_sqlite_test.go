@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"hr-system/shared"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// openSQLiteTestDB crea una base SQLite temporal con todas las migraciones
+// aplicadas, incluidos los datos iniciales.
+func openSQLiteTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", sqliteDSN(filepath.Join(t.TempDir(), "hr.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	migrator, err := NewMigrator(db, dialectSQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newSQLiteTestStore(t *testing.T) testStore {
+	t.Helper()
+	db := openSQLiteTestDB(t)
+	return newSQLTestStore(t, db, NewSQLiteStore(db))
+}
+
+func TestSQLiteMigrationsRoundTrip(t *testing.T) {
+	db := openSQLiteTestDB(t)
+	migrator, err := NewMigrator(db, dialectSQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := migrator.Check(); err != nil {
+		t.Fatal(err)
+	}
+	var empleados int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM empleados`).Scan(&empleados); err != nil {
+		t.Fatal(err)
+	}
+	if empleados != 5 {
+		t.Errorf("datos iniciales: %d empleados", empleados)
+	}
+	count, err := migrator.Down(len(migrator.migrations))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != len(migrator.migrations) {
+		t.Errorf("se revirtieron %d de %d migraciones", count, len(migrator.migrations))
+	}
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("las migraciones no se pueden reaplicar: %v", err)
+	}
+}
+
+func TestSQLiteAuditIsAppendOnly(t *testing.T) {
+	ctx := context.Background()
+	store := newSQLiteTestStore(t).(*sqlTestStore)
+	if err := store.InsertAudit(ctx, auditRecord{Operador: "admin", Cliente: "test", Operacion: AuditInsert, EmplID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.db.Exec(`UPDATE auditoria SET audit_operador='otro'`); err == nil ||
+		!strings.Contains(err.Error(), "solo inserción") {
+		t.Errorf("UPDATE de auditoría: %v", err)
+	}
+	if _, err := store.db.Exec(`DELETE FROM auditoria`); err == nil {
+		t.Error("se eliminó la auditoría")
+	}
+}
+
+func TestSQLiteUsuariosAndRoles(t *testing.T) {
+	ctx := context.Background()
+	db := openSQLiteTestDB(t)
+	usuarios := NewUsuarioCrud(db)
+	created, err := usuarios.EnsureAdmin(ctx, "admin", "Secreta123!")
+	if err != nil || !created {
+		t.Fatalf("EnsureAdmin: %v %v", created, err)
+	}
+	if _, err := usuarios.Create(ctx, shared.CreateUsuarioDTO{Usuario: "admin", Password: "Secreta123!", Rol: shared.RolAdmin}); err == nil ||
+		err.Error() != "el usuario ya existe" {
+		t.Errorf("usuario repetido: %v", err)
+	}
+	noEmpleado := 99
+	if _, err := usuarios.Create(ctx, shared.CreateUsuarioDTO{Usuario: "ana", Password: "Secreta123!", Rol: shared.RolAdmin,
+		EmplID: &noEmpleado}); err == nil || err.Error() != "el rol o el empleado asociado no existe" {
+		t.Errorf("empleado inexistente: %v", err)
+	}
+	activo := false
+	user, err := usuarios.Update(ctx, shared.UpdateUsuarioDTO{Usuario: "admin", Activo: &activo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Activo || user.Creado == "" {
+		t.Errorf("usuario actualizado: %+v", user)
+	}
+	if _, err := usuarios.Update(ctx, shared.UpdateUsuarioDTO{Usuario: "nadie", Activo: &activo}); err == nil {
+		t.Error("se actualizó un usuario inexistente")
+	}
+
+	authz := NewAuthorizer(db)
+	if err := authz.Load(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !authz.Allowed(ctx, "hr_manager", "DELETE") || authz.Allowed(ctx, "hr_analyst", "DELETE") {
+		t.Error("permisos de los roles iniciales")
+	}
+	if err := authz.DeleteRol(ctx, "hr_analyst"); err != nil {
+		t.Fatal(err)
+	}
+	if err := authz.DeleteRol(ctx, "hr_analyst"); err == nil {
+		t.Error("se eliminó dos veces el mismo rol")
+	}
+}
+
+func TestSQLiteDeleteKeepsHistorico(t *testing.T) {
+	ctx := context.Background()
+	store := newSQLiteTestStore(t)
+	cargo, _ := store.AddCargo("Analista", 1000, 5000)
+	dpto, _ := store.AddDepartamento("Ventas", "Av. Principal 100", "Lima")
+	id, err := store.InsertEmpleado(ctx, shared.CreateEmpleadoDTO{
+		PrimerNombre: "Ana", Email: "ana@empresa.com", FechaNac: "1990-05-15",
+		Sueldo: 2500, CargoID: cargo, DptoID: dpto,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errAbort := errors.New("abortar")
+	err = store.RunInTx(ctx, func(tx EmpleadoStore) error {
+		if err := tx.DeleteEmpleado(ctx, id); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("RunInTx: %v", err)
+	}
+	if historico, _ := store.ListHistorico(ctx); len(historico) != 0 {
+		t.Errorf("la transacción revertida dejó histórico: %+v", historico)
+	}
+	if err := store.DeleteEmpleado(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DeleteEmpleado(ctx, id); err == nil || !strings.Contains(err.Error(), "ya está eliminado") {
+		t.Errorf("segundo borrado: %v", err)
+	}
+	historico, err := store.ListHistorico(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(historico) != 1 || historico[0].CargoID != cargo || historico[0].DptoID != dpto ||
+		len(historico[0].FechaRetiro) != len(shared.FechaLayout) {
+		t.Errorf("histórico: %+v", historico)
+	}
+}
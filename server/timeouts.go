@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultRequestTimeout = 30 * time.Second
+
+// requestTimeouts es el plazo de cada operación del protocolo: porOperacion
+// tiene las excepciones y defecto se aplica al resto.
+type requestTimeouts struct {
+	defecto      time.Duration
+	porOperacion map[string]time.Duration
+}
+
+// defaultRequestTimeouts da más tiempo a las operaciones que pueden tocar
+// muchas filas.
+func defaultRequestTimeouts() requestTimeouts {
+	return requestTimeouts{
+		defecto: defaultRequestTimeout,
+		porOperacion: map[string]time.Duration{
+			"BATCH":      2 * time.Minute,
+			"LIST_AUDIT": time.Minute,
+		},
+	}
+}
+
+func (t requestTimeouts) forOperation(op string) time.Duration {
+	if timeout, ok := t.porOperacion[op]; ok {
+		return timeout
+	}
+	return t.defecto
+}
+
+// requestTimeoutsFromEnv lee REQUEST_TIMEOUT, el plazo por defecto, y
+// REQUEST_TIMEOUTS, las excepciones por operación con la forma
+// "BATCH=5m,LIST_AUDIT=90s".
+func requestTimeoutsFromEnv() (requestTimeouts, error) {
+	timeouts := defaultRequestTimeouts()
+	if v := os.Getenv("REQUEST_TIMEOUT"); v != "" {
+		timeout, err := parseTimeout(v)
+		if err != nil {
+			return requestTimeouts{}, fmt.Errorf("REQUEST_TIMEOUT inválido: %v", err)
+		}
+		timeouts.defecto = timeout
+	}
+	if v := os.Getenv("REQUEST_TIMEOUTS"); v != "" {
+		for _, item := range strings.Split(v, ",") {
+			op, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+			if !ok || op == "" {
+				return requestTimeouts{}, fmt.Errorf("REQUEST_TIMEOUTS inválido: se esperaba OPERACION=duración en '%s'", item)
+			}
+			timeout, err := parseTimeout(value)
+			if err != nil {
+				return requestTimeouts{}, fmt.Errorf("REQUEST_TIMEOUTS inválido para %s: %v", op, err)
+			}
+			timeouts.porOperacion[strings.ToUpper(op)] = timeout
+		}
+	}
+	return timeouts, nil
+}
+
+func parseTimeout(v string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("el plazo debe ser positivo")
+	}
+	return timeout, nil
+}
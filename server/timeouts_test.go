@@ -0,0 +1,37 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestTimeoutsFromEnv(t *testing.T) {
+	t.Setenv("REQUEST_TIMEOUT", "10s")
+	t.Setenv("REQUEST_TIMEOUTS", "batch=5m, LIST_AUDIT=90s")
+	timeouts, err := requestTimeoutsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for op, want := range map[string]time.Duration{
+		"SELECT":     10 * time.Second,
+		"BATCH":      5 * time.Minute,
+		"LIST_AUDIT": 90 * time.Second,
+	} {
+		if got := timeouts.forOperation(op); got != want {
+			t.Errorf("plazo de %s = %v, se esperaba %v", op, got, want)
+		}
+	}
+
+	for _, tc := range []struct{ defecto, porOperacion, message string }{
+		{"0s", "", "REQUEST_TIMEOUT inválido"},
+		{"", "BATCH", "se esperaba OPERACION=duración"},
+		{"", "BATCH=-1m", "REQUEST_TIMEOUTS inválido para BATCH"},
+	} {
+		t.Setenv("REQUEST_TIMEOUT", tc.defecto)
+		t.Setenv("REQUEST_TIMEOUTS", tc.porOperacion)
+		if _, err := requestTimeoutsFromEnv(); err == nil || !strings.Contains(err.Error(), tc.message) {
+			t.Errorf("%q %q: %v", tc.defecto, tc.porOperacion, err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Modos de verificación del certificado de cliente (TLS_CLIENT_AUTH).
+const (
+	clientAuthNone     = "none"
+	clientAuthOptional = "optional"
+	clientAuthRequire  = "require"
+)
+
+// tlsOptions describe la configuración TLS del servidor. Sin CertFile ni
+// KeyFile el servidor escucha en texto plano.
+type tlsOptions struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+	ClientAuth   string
+}
+
+func tlsOptionsFromEnv() tlsOptions {
+	return tlsOptions{
+		CertFile:     os.Getenv("TLS_CERT_FILE"),
+		KeyFile:      os.Getenv("TLS_KEY_FILE"),
+		ClientCAFile: os.Getenv("TLS_CLIENT_CA_FILE"),
+		ClientAuth:   strings.ToLower(os.Getenv("TLS_CLIENT_AUTH")),
+	}
+}
+
+// buildTLSConfig devuelve nil si TLS no está configurado. Con ClientCAFile
+// se verifican los certificados de cliente (mTLS); por defecto son
+// obligatorios, con ClientAuth=optional se aceptan clientes sin certificado.
+func buildTLSConfig(opts tlsOptions) (*tls.Config, error) {
+	if opts.CertFile == "" && opts.KeyFile == "" {
+		if opts.ClientCAFile != "" {
+			return nil, fmt.Errorf("TLS_CLIENT_CA_FILE requiere TLS_CERT_FILE y TLS_KEY_FILE")
+		}
+		return nil, nil
+	}
+	if opts.CertFile == "" || opts.KeyFile == "" {
+		return nil, fmt.Errorf("TLS requiere TLS_CERT_FILE y TLS_KEY_FILE")
+	}
+	cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error cargando certificado TLS: %v", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if opts.ClientCAFile == "" {
+		if opts.ClientAuth != "" && opts.ClientAuth != clientAuthNone {
+			return nil, fmt.Errorf("TLS_CLIENT_AUTH=%s requiere TLS_CLIENT_CA_FILE", opts.ClientAuth)
+		}
+		return config, nil
+	}
+	pem, err := os.ReadFile(opts.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo CA de clientes: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("el archivo %s no contiene certificados PEM válidos", opts.ClientCAFile)
+	}
+	config.ClientCAs = pool
+	switch opts.ClientAuth {
+	case "", clientAuthRequire:
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	case clientAuthOptional:
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	case clientAuthNone:
+		config.ClientAuth = tls.NoClientCert
+	default:
+		return nil, fmt.Errorf("TLS_CLIENT_AUTH '%s' no válido, use %s, %s o %s",
+			opts.ClientAuth, clientAuthNone, clientAuthOptional, clientAuthRequire)
+	}
+	return config, nil
+}
+
+// certificateUser devuelve el usuario que identifica el certificado de
+// cliente verificado: el Common Name del sujeto debe coincidir con
+// usr_nombre. Devuelve "" si la conexión no presentó certificado.
+func certificateUser(state tls.ConnectionState) string {
+	if len(state.VerifiedChains) == 0 || len(state.PeerCertificates) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(state.PeerCertificates[0].Subject.CommonName)
+}
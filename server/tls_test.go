@@ -0,0 +1,212 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert, isCA bool, usage x509.ExtKeyUsage) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if isCA {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+	} else {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+		tmpl.DNSNames = []string{"localhost"}
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+// write guarda el certificado y la clave en dir y devuelve sus rutas.
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+// handshake conecta un cliente con clientConfig a un listener TLS con
+// serverConfig y devuelve el usuario del certificado visto por el servidor.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (string, error) {
+	t.Helper()
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	type result struct {
+		usuario string
+		err     error
+	}
+	done := make(chan result, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		defer conn.Close()
+		tlsConn := conn.(*tls.Conn)
+		if err := tlsConn.Handshake(); err != nil {
+			done <- result{err: err}
+			return
+		}
+		done <- result{usuario: certificateUser(tlsConn.ConnectionState())}
+	}()
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err == nil {
+		// En TLS 1.3 el rechazo del certificado de cliente llega tras el
+		// handshake; una lectura lo hace visible.
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	r := <-done
+	return r.usuario, r.err
+}
+
+func TestBuildTLSConfigSinCertificado(t *testing.T) {
+	config, err := buildTLSConfig(tlsOptions{})
+	if err != nil || config != nil {
+		t.Fatalf("sin certificado se esperaba texto plano, obtuvo %v, %v", config, err)
+	}
+	if _, err := buildTLSConfig(tlsOptions{ClientCAFile: "ca.crt"}); err == nil {
+		t.Fatal("se esperaba error con CA de clientes sin certificado de servidor")
+	}
+	if _, err := buildTLSConfig(tlsOptions{CertFile: "server.crt"}); err == nil {
+		t.Fatal("se esperaba error sin archivo de clave")
+	}
+}
+
+func TestTLSMutuoIdentificaUsuario(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "hr-test-ca", nil, true, 0)
+	caFile, _ := ca.write(t, dir, "ca")
+	serverCert, serverKey := newTestCert(t, "localhost", ca, false, x509.ExtKeyUsageServerAuth).write(t, dir, "server")
+
+	serverConfig, err := buildTLSConfig(tlsOptions{
+		CertFile:     serverCert,
+		KeyFile:      serverKey,
+		ClientCAFile: caFile,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	client := newTestCert(t, "analista1", ca, false, x509.ExtKeyUsageClientAuth)
+	clientCertFile, clientKeyFile := client.write(t, dir, "client")
+	clientCert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usuario, err := handshake(t, serverConfig, &tls.Config{
+		RootCAs:      roots,
+		ServerName:   "localhost",
+		Certificates: []tls.Certificate{clientCert},
+	})
+	if err != nil {
+		t.Fatalf("handshake mTLS falló: %v", err)
+	}
+	if usuario != "analista1" {
+		t.Fatalf("usuario del certificado = %q, se esperaba analista1", usuario)
+	}
+
+	if _, err := handshake(t, serverConfig, &tls.Config{RootCAs: roots, ServerName: "localhost"}); err == nil {
+		t.Fatal("se esperaba rechazo de cliente sin certificado con TLS_CLIENT_AUTH=require")
+	}
+}
+
+func TestTLSMutuoRechazaCAAjena(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "hr-test-ca", nil, true, 0)
+	caFile, _ := ca.write(t, dir, "ca")
+	serverCert, serverKey := newTestCert(t, "localhost", ca, false, x509.ExtKeyUsageServerAuth).write(t, dir, "server")
+	serverConfig, err := buildTLSConfig(tlsOptions{
+		CertFile:     serverCert,
+		KeyFile:      serverKey,
+		ClientCAFile: caFile,
+		ClientAuth:   clientAuthOptional,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	usuario, err := handshake(t, serverConfig, &tls.Config{RootCAs: roots, ServerName: "localhost"})
+	if err != nil || usuario != "" {
+		t.Fatalf("con TLS_CLIENT_AUTH=optional se esperaba conexión anónima, obtuvo %q, %v", usuario, err)
+	}
+
+	otra := newTestCert(t, "otra-ca", nil, true, 0)
+	intruso := newTestCert(t, "admin", otra, false, x509.ExtKeyUsageClientAuth)
+	certFile, keyFile := intruso.write(t, dir, "intruso")
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Un certificado de otra CA nunca identifica al usuario: el cliente no lo
+	// presenta o el servidor lo rechaza.
+	usuario, err = handshake(t, serverConfig, &tls.Config{
+		RootCAs:      roots,
+		ServerName:   "localhost",
+		Certificates: []tls.Certificate{cert},
+	})
+	if err == nil && usuario != "" {
+		t.Fatalf("un certificado de otra CA no debe identificar al usuario, obtuvo %q", usuario)
+	}
+}
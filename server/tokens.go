@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"hr-system/shared"
+	"sync"
+	"time"
+)
+
+// tokenSession es una sesión del gateway HTTP o de gRPC, identificada por un
+// token Bearer en lugar de una conexión. Las peticiones de un mismo token se
+// serializan, igual que en una conexión de socket. usuario no cambia y se
+// lee sin tomar mu.
+type tokenSession struct {
+	mu      sync.Mutex
+	usuario string
+	sess    *session
+}
+
+// loginToken ejecuta LOGIN para sess y, si tiene éxito, registra la sesión
+// y devuelve el token en LoginResponseDTO.Token.
+func (s *Server) loginToken(ctx context.Context, sess *session, data any) shared.Response {
+	response := s.processRequest(ctx, sess, shared.Request{Operation: "LOGIN", Data: data})
+	if !response.Success {
+		return response
+	}
+	token, err := newSessionToken()
+	if err != nil {
+		return errorResponse(err)
+	}
+	s.storeTokenSession(token, sess)
+	// processRequest puede haber convertido los datos en un mapa al
+	// enmascararlos.
+	switch login := response.Data.(type) {
+	case shared.LoginResponseDTO:
+		login.Token = token
+		response.Data = login
+	case map[string]any:
+		login["token"] = token
+	}
+	return response
+}
+
+func (s *Server) logoutToken(token string) {
+	s.tokenMu.Lock()
+	delete(s.tokenSessions, token)
+	s.tokenMu.Unlock()
+}
+
+// processTokenRequest ejecuta req en la sesión del token. La sesión se
+// descarta si expiró por inactividad.
+func (s *Server) processTokenRequest(ctx context.Context, token string, req shared.Request) shared.Response {
+	return s.withTokenSession(token, func(sess *session) shared.Response {
+		return s.processRequest(ctx, sess, req)
+	})
+}
+
+// rowMasker enmascara una fila según el rol de la sesión.
+type rowMasker func(row any) (any, error)
+
+// streamTokenRequest ejecuta op en la sesión del token para las operaciones
+// que entregan sus filas de a una, como los streams de gRPC. Aplica el
+// límite de peticiones, la sesión, el permiso y el plazo de op igual que
+// processRequest; fn recorre las filas con el crud del usuario, que aplica
+// su alcance, y enmascara cada una con mask.
+func (s *Server) streamTokenRequest(ctx context.Context, token, op string,
+	fn func(ctx context.Context, crud *EmpleadoCrud, mask rowMasker) error) shared.Response {
+	return s.withTokenSession(token, func(sess *session) shared.Response {
+		if !s.limiter.allow(rateKey(sess)) {
+			return rateLimitedResponse()
+		}
+		if response, ok := s.checkSession(sess); !ok {
+			return response
+		}
+		if response, ok := s.authorize(ctx, sess, op); !ok {
+			return response
+		}
+		timeout := s.timeouts.forOperation(op)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		hidden := s.authz.HiddenCategories(ctx, sess.user.Rol)
+		err := fn(ctx, s.crud.WithActor(sess.actor(hidden)), func(row any) (any, error) {
+			return shared.MaskFields(row, hidden)
+		})
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return timeoutResponse(op, timeout)
+			}
+			return errorResponse(err)
+		}
+		return shared.Response{Success: true}
+	})
+}
+
+// withTokenSession ejecuta fn con la sesión del token, serializada con las
+// demás peticiones del mismo token. La sesión se descarta si expiró.
+func (s *Server) withTokenSession(token string, fn func(sess *session) shared.Response) shared.Response {
+	ts := s.lookupTokenSession(token)
+	if ts == nil {
+		return shared.Response{
+			Success: false,
+			Code:    shared.CodeUnauthorized,
+			Message: "Debe iniciar sesión con LOGIN y enviar el token en Authorization: Bearer",
+		}
+	}
+	ts.mu.Lock()
+	response := fn(ts.sess)
+	expired := ts.sess.user == nil
+	ts.mu.Unlock()
+	if expired {
+		s.logoutToken(token)
+	}
+	return response
+}
+
+func (s *Server) storeTokenSession(token string, sess *session) {
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+	if s.tokenSessions == nil {
+		s.tokenSessions = make(map[string]*tokenSession)
+	}
+	for t, ts := range s.tokenSessions {
+		ts.mu.Lock()
+		idle := time.Since(ts.sess.lastActivity) > s.sessionIdleTimeout
+		ts.mu.Unlock()
+		if idle {
+			delete(s.tokenSessions, t)
+		}
+	}
+	s.tokenSessions[token] = &tokenSession{usuario: sess.user.Usuario, sess: sess}
+}
+
+func (s *Server) lookupTokenSession(token string) *tokenSession {
+	if token == "" {
+		return nil
+	}
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+	return s.tokenSessions[token]
+}
+
+func newSessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", internalError("error generando token de sesión: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
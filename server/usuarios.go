@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hr-system/shared"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type UsuarioCrud struct {
+	db *sql.DB
+}
+
+func NewUsuarioCrud(db *sql.DB) *UsuarioCrud {
+	return &UsuarioCrud{db: db}
+}
+
+// usuarioAutenticado es el usuario asociado a una sesión.
+type usuarioAutenticado struct {
+	ID      int
+	Usuario string
+	Rol     string
+	Alcance string
+	EmplID  *int
+}
+
+var errCredencialesInvalidas = errors.New("usuario o contraseña incorrectos")
+
+// dummyHash se compara cuando el usuario no existe para que la respuesta
+// tarde lo mismo y no revele qué usuarios existen.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("usuario-inexistente"), bcrypt.DefaultCost)
+
+func (c *UsuarioCrud) Authenticate(ctx context.Context, usuario, password string) (*usuarioAutenticado, error) {
+	user, hash, activo, err := c.lookup(ctx, usuario)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+			return nil, errCredencialesInvalidas
+		}
+		return nil, internalError("error consultando usuario: %v", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return nil, errCredencialesInvalidas
+	}
+	if !activo {
+		return nil, errCredencialesInvalidas
+	}
+	return user, nil
+}
+
+// AuthenticateCertificate identifica al usuario por el certificado de
+// cliente ya verificado por TLS, sin contraseña.
+func (c *UsuarioCrud) AuthenticateCertificate(ctx context.Context, usuario string) (*usuarioAutenticado, error) {
+	user, _, activo, err := c.lookup(ctx, usuario)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("el certificado no corresponde a ningún usuario")
+		}
+		return nil, internalError("error consultando usuario: %v", err)
+	}
+	if !activo {
+		return nil, fmt.Errorf("el usuario del certificado está inactivo")
+	}
+	return user, nil
+}
+
+func (c *UsuarioCrud) lookup(ctx context.Context, usuario string) (*usuarioAutenticado, string, bool, error) {
+	var user usuarioAutenticado
+	var hash string
+	var activo bool
+	err := c.db.QueryRowContext(ctx, `
+		SELECT u.usr_ID, u.usr_nombre, u.usr_password_hash, u.usr_rol, r.rol_alcance,
+		       u.usr_empl_ID, u.usr_activo
+		FROM usuarios u
+		INNER JOIN roles r ON u.usr_rol = r.rol_nombre
+		WHERE u.usr_nombre=$1`, usuario).Scan(&user.ID, &user.Usuario, &hash, &user.Rol, &user.Alcance,
+		&user.EmplID, &activo)
+	if err != nil {
+		return nil, "", false, err
+	}
+	return &user, hash, activo, nil
+}
+
+func (c *UsuarioCrud) Create(ctx context.Context, dto shared.CreateUsuarioDTO) (*shared.UsuarioDTO, error) {
+	dto.Usuario = strings.TrimSpace(dto.Usuario)
+	if err := shared.ValidateUsuario(dto.Usuario); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
+	}
+	if err := shared.ValidatePassword(dto.Password); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
+	}
+	if err := shared.ValidateRol(dto.Rol); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(dto.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, internalError("error generando hash de contraseña: %v", err)
+	}
+	var user shared.UsuarioDTO
+	var creado time.Time
+	err = c.db.QueryRowContext(ctx, `
+		INSERT INTO usuarios (usr_nombre, usr_password_hash, usr_rol, usr_empl_ID)
+		VALUES ($1, $2, $3, $4)
+		RETURNING usr_ID, usr_nombre, usr_rol, usr_empl_ID, usr_activo, usr_creado`,
+		dto.Usuario, string(hash), dto.Rol, dto.EmplID).Scan(&user.ID, &user.Usuario, &user.Rol, &user.EmplID,
+		&user.Activo, &creado)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("el usuario ya existe")
+		}
+		if isForeignKeyViolation(err) {
+			return nil, fmt.Errorf("el rol o el empleado asociado no existe")
+		}
+		return nil, internalError("error creando usuario: %v", err)
+	}
+	user.Creado = creado.Format(time.RFC3339)
+	return &user, nil
+}
+
+func (c *UsuarioCrud) Update(ctx context.Context, dto shared.UpdateUsuarioDTO) (*shared.UsuarioDTO, error) {
+	var sets []string
+	var args []any
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s=$%d", column, len(args)))
+	}
+	if dto.Password != nil {
+		if err := shared.ValidatePassword(*dto.Password); err != nil {
+			return nil, fmt.Errorf("validación fallida: %v", err)
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(*dto.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, internalError("error generando hash de contraseña: %v", err)
+		}
+		set("usr_password_hash", string(hash))
+	}
+	if dto.Rol != nil {
+		if err := shared.ValidateRol(*dto.Rol); err != nil {
+			return nil, fmt.Errorf("validación fallida: %v", err)
+		}
+		set("usr_rol", *dto.Rol)
+	}
+	if dto.Activo != nil {
+		set("usr_activo", *dto.Activo)
+	}
+	if dto.EmplID != nil && dto.DesvincularEmpleado {
+		return nil, fmt.Errorf("validación fallida: no se puede vincular y desvincular el empleado a la vez")
+	}
+	if dto.EmplID != nil {
+		set("usr_empl_ID", *dto.EmplID)
+	}
+	if dto.DesvincularEmpleado {
+		set("usr_empl_ID", nil)
+	}
+	if len(sets) == 0 {
+		return nil, fmt.Errorf("validación fallida: no se enviaron campos para actualizar")
+	}
+	args = append(args, dto.Usuario)
+	query := fmt.Sprintf(`
+		UPDATE usuarios SET %s WHERE usr_nombre=$%d
+		RETURNING usr_ID, usr_nombre, usr_rol, usr_empl_ID, usr_activo, usr_creado`, strings.Join(sets, ", "), len(args))
+	var user shared.UsuarioDTO
+	var creado time.Time
+	err := c.db.QueryRowContext(ctx, query, args...).Scan(&user.ID, &user.Usuario, &user.Rol, &user.EmplID, &user.Activo, &creado)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, notFound("usuario no encontrado")
+		}
+		if isForeignKeyViolation(err) {
+			return nil, fmt.Errorf("el rol o el empleado asociado no existe")
+		}
+		return nil, internalError("error actualizando usuario: %v", err)
+	}
+	user.Creado = creado.Format(time.RFC3339)
+	return &user, nil
+}
+
+func (c *UsuarioCrud) List(ctx context.Context) ([]shared.UsuarioDTO, error) {
+	rows, err := c.db.QueryContext(ctx, `
+		SELECT usr_ID, usr_nombre, usr_rol, usr_empl_ID, usr_activo, usr_creado
+		FROM usuarios ORDER BY usr_ID`)
+	if err != nil {
+		return nil, internalError("error consultando usuarios: %v", err)
+	}
+	defer rows.Close()
+	var usuarios []shared.UsuarioDTO
+	for rows.Next() {
+		var user shared.UsuarioDTO
+		var creado time.Time
+		if err := rows.Scan(&user.ID, &user.Usuario, &user.Rol, &user.EmplID, &user.Activo, &creado); err != nil {
+			return nil, internalError("error escaneando usuario: %v", err)
+		}
+		user.Creado = creado.Format(time.RFC3339)
+		usuarios = append(usuarios, user)
+	}
+	return usuarios, nil
+}
+
+// EnsureAdmin crea el usuario administrador inicial cuando la tabla está
+// vacía, para que exista al menos una cuenta con la que iniciar sesión.
+func (c *UsuarioCrud) EnsureAdmin(ctx context.Context, usuario, password string) (bool, error) {
+	var count int
+	if err := c.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM usuarios`).Scan(&count); err != nil {
+		return false, internalError("error contando usuarios: %v", err)
+	}
+	if count > 0 {
+		return false, nil
+	}
+	if password == "" {
+		return false, fmt.Errorf("no hay usuarios registrados y HR_ADMIN_PASSWORD no está definida")
+	}
+	_, err := c.Create(ctx, shared.CreateUsuarioDTO{Usuario: usuario, Password: password, Rol: shared.RolAdmin})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,490 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hr-system/shared"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Reintentos de entrega: la espera se duplica desde webhookBackoffBase hasta
+// webhookBackoffMax. Tras webhookMaxIntentos la entrega queda como fallida.
+// Una entrega en curso queda reclamada durante webhookReclamo; si la
+// instancia que la envía cae, otra la retoma al vencer ese plazo.
+const (
+	webhookMaxIntentos   = 8
+	webhookBackoffBase   = 10 * time.Second
+	webhookBackoffMax    = time.Hour
+	webhookPollInterval  = 2 * time.Second
+	webhookTimeout       = 10 * time.Second
+	webhookReclamo       = time.Minute
+	webhookLote          = 20
+	maxWebhookDeadLetter = 500
+)
+
+// Encabezados de cada entrega. La firma es HMAC-SHA256 con el secreto del
+// webhook sobre "<timestamp>.<cuerpo>", en hexadecimal con prefijo sha256=.
+const (
+	headerEvento    = "X-HR-Evento"
+	headerEntrega   = "X-HR-Entrega"
+	headerTimestamp = "X-HR-Timestamp"
+	headerFirma     = "X-HR-Firma"
+)
+
+type WebhookCrud struct {
+	db      *sql.DB
+	dialect dialect
+}
+
+func NewWebhookCrud(db *sql.DB, d dialect) *WebhookCrud {
+	return &WebhookCrud{db: db, dialect: d}
+}
+
+// tiposArg y tiposDest adaptan wh_tipos, que es un arreglo en Postgres y un
+// arreglo JSON de texto en SQLite.
+func (c *WebhookCrud) tiposArg(tipos []string) any {
+	if c.dialect == dialectSQLite {
+		data, _ := json.Marshal(tipos)
+		return string(data)
+	}
+	return pq.Array(tipos)
+}
+
+func (c *WebhookCrud) tiposDest(tipos *[]string) any {
+	if c.dialect == dialectSQLite {
+		return jsonTipos{tipos}
+	}
+	return pq.Array(tipos)
+}
+
+// jsonTipos lee el arreglo JSON de wh_tipos en SQLite.
+type jsonTipos struct {
+	tipos *[]string
+}
+
+func (j jsonTipos) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(v), j.tipos)
+	case []byte:
+		return json.Unmarshal(v, j.tipos)
+	}
+	return fmt.Errorf("wh_tipos con tipo inesperado %T", src)
+}
+
+func (c *WebhookCrud) Create(ctx context.Context, dto shared.CreateWebhookDTO) (*shared.WebhookDTO, error) {
+	if err := shared.ValidateCreateWebhook(dto); err != nil {
+		return nil, fmt.Errorf("validación fallida: %v", err)
+	}
+	tipos := dto.Tipos
+	if tipos == nil {
+		tipos = []string{}
+	}
+	var wh shared.WebhookDTO
+	var creado time.Time
+	err := c.db.QueryRowContext(ctx, `
+		INSERT INTO webhooks (wh_url, wh_tipos, wh_secreto) VALUES ($1, $2, $3)
+		RETURNING wh_ID, wh_url, wh_tipos, wh_activo, wh_creado`,
+		dto.URL, c.tiposArg(tipos), dto.Secreto).Scan(&wh.ID, &wh.URL, c.tiposDest(&wh.Tipos), &wh.Activo, &creado)
+	if err != nil {
+		return nil, internalError("error creando webhook: %v", err)
+	}
+	wh.Creado = creado.Format(time.RFC3339)
+	return &wh, nil
+}
+
+func (c *WebhookCrud) List(ctx context.Context) ([]shared.WebhookDTO, error) {
+	rows, err := c.db.QueryContext(ctx, `
+		SELECT wh_ID, wh_url, wh_tipos, wh_activo, wh_creado
+		FROM webhooks ORDER BY wh_ID`)
+	if err != nil {
+		return nil, internalError("error consultando webhooks: %v", err)
+	}
+	defer rows.Close()
+	webhooks := []shared.WebhookDTO{}
+	for rows.Next() {
+		var wh shared.WebhookDTO
+		var creado time.Time
+		if err := rows.Scan(&wh.ID, &wh.URL, c.tiposDest(&wh.Tipos), &wh.Activo, &creado); err != nil {
+			return nil, internalError("error escaneando webhook: %v", err)
+		}
+		wh.Creado = creado.Format(time.RFC3339)
+		webhooks = append(webhooks, wh)
+	}
+	return webhooks, rows.Err()
+}
+
+// SetActivo activa o desactiva un webhook. Uno inactivo no recibe eventos
+// nuevos y sus entregas pendientes esperan a que se reactive.
+func (c *WebhookCrud) SetActivo(ctx context.Context, dto shared.UpdateWebhookDTO) (*shared.WebhookDTO, error) {
+	if dto.Activo == nil {
+		return nil, fmt.Errorf("validación fallida: no se enviaron campos para actualizar")
+	}
+	var wh shared.WebhookDTO
+	var creado time.Time
+	err := c.db.QueryRowContext(ctx, `
+		UPDATE webhooks SET wh_activo=$2 WHERE wh_ID=$1
+		RETURNING wh_ID, wh_url, wh_tipos, wh_activo, wh_creado`,
+		dto.ID, *dto.Activo).Scan(&wh.ID, &wh.URL, c.tiposDest(&wh.Tipos), &wh.Activo, &creado)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, notFound("webhook no encontrado")
+		}
+		return nil, internalError("error actualizando webhook: %v", err)
+	}
+	wh.Creado = creado.Format(time.RFC3339)
+	return &wh, nil
+}
+
+// Delete elimina el webhook y sus entregas pendientes o fallidas.
+func (c *WebhookCrud) Delete(ctx context.Context, id int) error {
+	result, err := c.db.ExecContext(ctx, `DELETE FROM webhooks WHERE wh_ID=$1`, id)
+	if err != nil {
+		return internalError("error eliminando webhook: %v", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return notFound("webhook no encontrado")
+	}
+	return nil
+}
+
+func (c *WebhookCrud) ListFallidas(ctx context.Context) ([]shared.EntregaWebhookDTO, error) {
+	rows, err := c.db.QueryContext(ctx, `
+		SELECT o.out_ID, o.out_wh_ID, w.wh_url, o.out_tipo, o.out_intentos,
+		       COALESCE(o.out_ultimo_error, ''), o.out_creado, o.out_payload
+		FROM webhook_outbox o
+		INNER JOIN webhooks w ON w.wh_ID = o.out_wh_ID
+		WHERE o.out_estado = 'fallido'
+		ORDER BY o.out_ID DESC
+		LIMIT $1`, maxWebhookDeadLetter)
+	if err != nil {
+		return nil, internalError("error consultando entregas fallidas: %v", err)
+	}
+	defer rows.Close()
+	entregas := []shared.EntregaWebhookDTO{}
+	for rows.Next() {
+		var e shared.EntregaWebhookDTO
+		var creado time.Time
+		var payload []byte
+		if err := rows.Scan(&e.ID, &e.WebhookID, &e.URL, &e.Tipo, &e.Intentos,
+			&e.UltimoError, &creado, &payload); err != nil {
+			return nil, internalError("error escaneando entrega: %v", err)
+		}
+		e.Creado = creado.Format(time.RFC3339)
+		e.Payload = payload
+		entregas = append(entregas, e)
+	}
+	return entregas, rows.Err()
+}
+
+// Retry devuelve una entrega fallida a la cola con los intentos en cero.
+func (c *WebhookCrud) Retry(ctx context.Context, id int64) error {
+	result, err := c.db.ExecContext(ctx, `
+		UPDATE webhook_outbox
+		SET out_estado='pendiente', out_intentos=0, out_proximo=`+c.dialect.now()+`
+		WHERE out_ID=$1 AND out_estado='fallido'`, id)
+	if err != nil {
+		return internalError("error reintentando entrega: %v", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return notFound("entrega fallida no encontrada")
+	}
+	return nil
+}
+
+// webhookBackoff es la espera antes del siguiente intento tras intentos
+// fallidos.
+func webhookBackoff(intentos int) time.Duration {
+	wait := webhookBackoffBase
+	for i := 1; i < intentos; i++ {
+		wait *= 2
+		if wait >= webhookBackoffMax {
+			return webhookBackoffMax
+		}
+	}
+	return wait
+}
+
+func signWebhook(secreto string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secreto))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliverWebhook envía una entrega firmada. Solo un estado 2xx cuenta como
+// entregada; cancelar ctx interrumpe la entrega.
+func deliverWebhook(ctx context.Context, client *http.Client, url, secreto, tipo string, id int64, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerEvento, tipo)
+	req.Header.Set(headerEntrega, strconv.FormatInt(id, 10))
+	req.Header.Set(headerTimestamp, timestamp)
+	req.Header.Set(headerFirma, signWebhook(secreto, timestamp, payload))
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("respuesta HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// runWebhooks entrega las entregas pendientes del outbox hasta que ctx se
+// cancela. En Postgres cada entrega se reclama con SKIP LOCKED, así que
+// varias instancias pueden repartirse la cola.
+func (c *WebhookCrud) runWebhooks(ctx context.Context, client *http.Client) {
+	for ctx.Err() == nil {
+		n, err := c.deliverPending(ctx, client)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Error entregando webhooks: %v", err)
+		}
+		if n < webhookLote {
+			select {
+			case <-time.After(webhookPollInterval):
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+func (c *WebhookCrud) deliverPending(ctx context.Context, client *http.Client) (int, error) {
+	for i := 0; i < webhookLote; i++ {
+		ok, err := c.deliverNext(ctx, client)
+		if err != nil || !ok {
+			return i, err
+		}
+	}
+	return webhookLote, nil
+}
+
+// deliverNext reclama la siguiente entrega pendiente de un webhook activo y
+// la envía. El reclamo se confirma antes de enviar, para no mantener una
+// transacción abierta durante la petición HTTP.
+func (c *WebhookCrud) deliverNext(ctx context.Context, client *http.Client) (bool, error) {
+	var id int64
+	var url, secreto, tipo string
+	var payload []byte
+	var intentos int
+	claim := `
+		UPDATE webhook_outbox o
+		SET out_proximo = NOW() + $1 * INTERVAL '1 second'
+		FROM webhooks w
+		WHERE w.wh_ID = o.out_wh_ID
+		  AND o.out_ID = (
+			SELECT p.out_ID
+			FROM webhook_outbox p
+			INNER JOIN webhooks pw ON pw.wh_ID = p.out_wh_ID
+			WHERE p.out_estado = 'pendiente' AND p.out_proximo <= NOW() AND pw.wh_activo
+			ORDER BY p.out_ID
+			LIMIT 1
+			FOR UPDATE OF p SKIP LOCKED)
+		RETURNING o.out_ID, w.wh_url, w.wh_secreto, o.out_tipo, o.out_payload, o.out_intentos`
+	if c.dialect == dialectSQLite {
+		claim = sqliteClaimEntrega
+	}
+	err := c.db.QueryRowContext(ctx, claim,
+		webhookReclamo.Seconds()).Scan(&id, &url, &secreto, &tipo, &payload, &intentos)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, internalError("error leyendo outbox: %v", err)
+	}
+	deliveryErr := deliverWebhook(ctx, client, url, secreto, tipo, id, payload)
+	// El resultado se registra aunque el servidor se esté apagando: una
+	// entrega enviada no debe repetirse.
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), webhookTimeout)
+	defer cancel()
+	switch {
+	case deliveryErr != nil && ctx.Err() != nil:
+		// Interrumpida por el apagado: se libera sin contar el intento.
+		_, err = c.db.ExecContext(recordCtx, `
+			UPDATE webhook_outbox SET out_proximo=`+c.dialect.now()+`
+			WHERE out_ID=$1 AND out_estado='pendiente'`, id)
+	case deliveryErr != nil:
+		intentos++
+		estado := "pendiente"
+		if intentos >= webhookMaxIntentos {
+			estado = "fallido"
+			log.Printf("Entrega %d a %s fallida tras %d intentos: %v", id, url, intentos, deliveryErr)
+		}
+		_, err = c.db.ExecContext(recordCtx, `
+			UPDATE webhook_outbox
+			SET out_intentos=$2, out_estado=$3, out_ultimo_error=$4, out_proximo=`+c.dialect.nowPlus("$5")+`
+			WHERE out_ID=$1 AND out_estado='pendiente'`, id, intentos, estado, deliveryErr.Error(), webhookBackoff(intentos).Seconds())
+	default:
+		_, err = c.db.ExecContext(recordCtx, `
+			UPDATE webhook_outbox
+			SET out_estado='entregado', out_intentos=out_intentos+1, out_entregado=`+c.dialect.now()+`, out_ultimo_error=NULL
+			WHERE out_ID=$1`, id)
+	}
+	if err != nil {
+		return false, internalError("error actualizando entrega %d: %v", id, err)
+	}
+	return true, nil
+}
+
+// sqliteClaimEntrega es el reclamo de deliverNext en SQLite, que no admite
+// UPDATE ... FROM en RETURNING ni SKIP LOCKED; no hace falta, porque la
+// sentencia bloquea toda la base de datos.
+const sqliteClaimEntrega = `
+	UPDATE webhook_outbox
+	SET out_proximo = strftime('%Y-%m-%d %H:%M:%f', 'now', $1 || ' seconds')
+	WHERE out_ID = (
+		SELECT p.out_ID
+		FROM webhook_outbox p
+		INNER JOIN webhooks pw ON pw.wh_ID = p.out_wh_ID
+		WHERE p.out_estado = 'pendiente' AND p.out_proximo <= strftime('%Y-%m-%d %H:%M:%f', 'now') AND pw.wh_activo
+		ORDER BY p.out_ID
+		LIMIT 1)
+	RETURNING out_ID,
+		(SELECT wh_url FROM webhooks WHERE wh_ID = out_wh_ID),
+		(SELECT wh_secreto FROM webhooks WHERE wh_ID = out_wh_ID),
+		out_tipo, out_payload, out_intentos`
+
+func (s *Server) handleCreateWebhook(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.CreateWebhookDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	result, err := s.webhooks.Create(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Webhook registrado exitosamente",
+		Data:    result,
+	}
+}
+
+func (s *Server) handleListWebhooks(ctx context.Context) shared.Response {
+	webhooks, err := s.webhooks.List(ctx)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Lista de webhooks obtenida",
+		Data:    webhooks,
+	}
+}
+
+func (s *Server) handleUpdateWebhook(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.UpdateWebhookDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	result, err := s.webhooks.SetActivo(ctx, dto)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Webhook actualizado exitosamente",
+		Data:    result,
+	}
+}
+
+func (s *Server) handleDeleteWebhook(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.DeleteWebhookDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	if err := s.webhooks.Delete(ctx, dto.ID); err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Webhook eliminado exitosamente",
+	}
+}
+
+func (s *Server) handleListEntregasFallidas(ctx context.Context) shared.Response {
+	entregas, err := s.webhooks.ListFallidas(ctx)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Entregas fallidas obtenidas",
+		Data:    entregas,
+	}
+}
+
+func (s *Server) handleRetryEntrega(ctx context.Context, data interface{}) shared.Response {
+	var dto shared.RetryEntregaDTO
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error procesando datos: %v", err),
+		}
+	}
+	if err := json.Unmarshal(jsonData, &dto); err != nil {
+		return shared.Response{
+			Success: false,
+			Message: fmt.Sprintf("Error en formato de datos: %v", err),
+		}
+	}
+	if err := s.webhooks.Retry(ctx, dto.ID); err != nil {
+		return errorResponse(err)
+	}
+	return shared.Response{
+		Success: true,
+		Message: "Entrega devuelta a la cola",
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"database/sql"
+	"encoding/json"
+	"hr-system/shared"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDeliverWebhookFirmaEntrega(t *testing.T) {
+	const secreto = "secreto-de-prueba-123"
+	payload := []byte(`{"tipo":"empleado.created","id":7}`)
+	var recibido struct {
+		body   []byte
+		header http.Header
+	}
+	standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recibido.body, _ = io.ReadAll(r.Body)
+		recibido.header = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer standIn.Close()
+
+	if err := deliverWebhook(context.Background(), standIn.Client(), standIn.URL, secreto, "empleado.created", 42, payload); err != nil {
+		t.Fatalf("entrega falló: %v", err)
+	}
+	if string(recibido.body) != string(payload) {
+		t.Errorf("cuerpo = %s", recibido.body)
+	}
+	if got := recibido.header.Get(headerEvento); got != "empleado.created" {
+		t.Errorf("%s = %q", headerEvento, got)
+	}
+	if got := recibido.header.Get(headerEntrega); got != "42" {
+		t.Errorf("%s = %q", headerEntrega, got)
+	}
+	want := signWebhook(secreto, recibido.header.Get(headerTimestamp), recibido.body)
+	if !hmac.Equal([]byte(recibido.header.Get(headerFirma)), []byte(want)) {
+		t.Errorf("firma = %q, se esperaba %q", recibido.header.Get(headerFirma), want)
+	}
+	if signWebhook("otro-secreto-distinto", recibido.header.Get(headerTimestamp), recibido.body) == want {
+		t.Error("la firma no depende del secreto")
+	}
+}
+
+func TestDeliverWebhookErrorHTTP(t *testing.T) {
+	standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer standIn.Close()
+	if err := deliverWebhook(context.Background(), standIn.Client(), standIn.URL, "secreto-de-prueba-123", "empleado.deleted", 1, []byte(`{}`)); err == nil {
+		t.Fatal("se esperaba error con respuesta 503")
+	}
+}
+
+func TestDeliverWebhookCancelada(t *testing.T) {
+	release := make(chan struct{})
+	standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer standIn.Close()
+	defer close(release)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := deliverWebhook(ctx, standIn.Client(), standIn.URL, "secreto-de-prueba-123", "empleado.updated", 1, []byte(`{}`))
+	if err == nil || ctx.Err() == nil {
+		t.Fatalf("se esperaba una entrega cancelada: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("la cancelación tardó %v", elapsed)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	casos := map[int]time.Duration{
+		1:  webhookBackoffBase,
+		2:  2 * webhookBackoffBase,
+		3:  4 * webhookBackoffBase,
+		20: webhookBackoffMax,
+	}
+	for intentos, want := range casos {
+		if got := webhookBackoff(intentos); got != want {
+			t.Errorf("webhookBackoff(%d) = %v, se esperaba %v", intentos, got, want)
+		}
+	}
+}
+
+func TestWebhookOutbox(t *testing.T) {
+	for _, backend := range integrationBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			db, cfg := backend.open(t)
+			testWebhookOutbox(t, db, cfg.dialect)
+		})
+	}
+}
+
+func testWebhookOutbox(t *testing.T, db *sql.DB, d dialect) {
+	ctx := context.Background()
+	var bloqueada, fallar atomic.Bool
+	var recibido atomic.Value
+	standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if d == dialectPostgres {
+			// La entrega ya está reclamada y confirmada: la fila no sigue
+			// bloqueada durante la petición.
+			_, err := db.ExecContext(r.Context(), `SELECT out_ID FROM webhook_outbox FOR UPDATE NOWAIT`)
+			bloqueada.Store(err != nil)
+		}
+		body, _ := io.ReadAll(r.Body)
+		recibido.Store(body)
+		if fallar.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer standIn.Close()
+	insertCargo := func(nombre string) {
+		t.Helper()
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO cargos (cargo_nombre, cargo_sueldo_minimo, cargo_sueldo_maximo) VALUES ($1, 1000, 2000)`, nombre)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	estados := func() []string {
+		t.Helper()
+		rows, err := db.QueryContext(ctx, `SELECT out_estado FROM webhook_outbox ORDER BY out_ID`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var estados []string
+		for rows.Next() {
+			var estado string
+			rows.Scan(&estado)
+			estados = append(estados, estado)
+		}
+		return estados
+	}
+
+	webhooks := NewWebhookCrud(db, d)
+	wh, err := webhooks.Create(ctx, shared.CreateWebhookDTO{URL: standIn.URL, Secreto: "secreto-de-prueba-123",
+		Tipos: []string{"cargo.*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wh.Tipos) != 1 || wh.Tipos[0] != "cargo.*" || !wh.Activo || wh.Creado == "" {
+		t.Fatalf("webhook creado: %+v", wh)
+	}
+	inactivo, activo := false, true
+	if _, err := webhooks.SetActivo(ctx, shared.UpdateWebhookDTO{ID: wh.ID, Activo: &inactivo}); err != nil {
+		t.Fatal(err)
+	}
+	// Un webhook inactivo no recibe eventos nuevos.
+	insertCargo("Cargo sin webhook")
+	if got := estados(); len(got) != 0 {
+		t.Fatalf("se encoló para un webhook inactivo: %v", got)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO webhook_outbox (out_wh_ID, out_tipo, out_payload) VALUES ($1, 'cargo.created', '{}')`, wh.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := webhooks.deliverNext(ctx, standIn.Client()); ok || err != nil {
+		t.Fatalf("se entregó a un webhook inactivo: %v %v", ok, err)
+	}
+
+	if _, err := webhooks.SetActivo(ctx, shared.UpdateWebhookDTO{ID: wh.ID, Activo: &activo}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := webhooks.deliverNext(ctx, standIn.Client()); !ok || err != nil {
+		t.Fatalf("deliverNext: %v %v", ok, err)
+	}
+	if bloqueada.Load() {
+		t.Error("la entrega se envió con la fila bloqueada")
+	}
+
+	// Los cambios se encolan con la fila bajo la clave de la entidad.
+	insertCargo("Cargo con webhook")
+	if ok, err := webhooks.deliverNext(ctx, standIn.Client()); !ok || err != nil {
+		t.Fatalf("deliverNext: %v %v", ok, err)
+	}
+	var payload struct {
+		Tipo  string `json:"tipo"`
+		Cargo struct {
+			Nombre string `json:"cargo_nombre"`
+		} `json:"cargo"`
+	}
+	if err := json.Unmarshal(recibido.Load().([]byte), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Tipo != shared.EventoCargoCreado || payload.Cargo.Nombre != "Cargo con webhook" {
+		t.Errorf("payload = %s", recibido.Load())
+	}
+	if got := estados(); len(got) != 2 || got[0] != "entregado" || got[1] != "entregado" {
+		t.Errorf("estados = %v", got)
+	}
+
+	// La entrega que agota los reintentos queda fallida y puede reintentarse.
+	fallar.Store(true)
+	insertCargo("Cargo fallido")
+	if _, err := db.ExecContext(ctx, `UPDATE webhook_outbox SET out_intentos = $1 WHERE out_estado = 'pendiente'`,
+		webhookMaxIntentos-1); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := webhooks.deliverNext(ctx, standIn.Client()); !ok || err != nil {
+		t.Fatalf("deliverNext: %v %v", ok, err)
+	}
+	fallidas, err := webhooks.ListFallidas(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fallidas) != 1 || fallidas[0].Intentos != webhookMaxIntentos || fallidas[0].UltimoError == "" {
+		t.Fatalf("entregas fallidas: %+v", fallidas)
+	}
+	if err := webhooks.Retry(ctx, fallidas[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	fallar.Store(false)
+	if ok, err := webhooks.deliverNext(ctx, standIn.Client()); !ok || err != nil {
+		t.Fatalf("deliverNext tras reintentar: %v %v", ok, err)
+	}
+	if got := estados(); len(got) != 3 || got[2] != "entregado" {
+		t.Errorf("estados = %v", got)
+	}
+
+	if _, err := webhooks.SetActivo(ctx, shared.UpdateWebhookDTO{ID: wh.ID + 100, Activo: &activo}); errorResponse(err).Code != shared.CodeNotFound {
+		t.Errorf("webhook inexistente: %v", err)
+	}
+	if err := webhooks.Delete(ctx, wh.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := estados(); len(got) != 0 {
+		t.Errorf("quedaron entregas del webhook eliminado: %v", got)
+	}
+}
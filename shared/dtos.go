@@ -1,5 +1,7 @@
 package shared
 
+import "encoding/json"
+
 type CreateEmpleadoDTO struct {
 	PrimerNombre  string  `json:"empl_primer_nombre"`
 	SegundoNombre *string `json:"empl_segundo_nombre"`
@@ -23,6 +25,30 @@ type UpdateEmpleadoDTO struct {
 	CargoID       int     `json:"empl_cargo_id"`
 	GerenteID     *int    `json:"empl_gerente_id"`
 	DptoID        int     `json:"empl_dpto_id"`
+	Version       int     `json:"empl_version"`
+}
+
+// PatchEmpleadoDTO solo modifica los campos enviados. Los campos opcionales
+// de la tabla se ponen en NULL con LimpiarSegundoNombre y LimpiarGerente.
+type PatchEmpleadoDTO struct {
+	ID                   int      `json:"empl_id"`
+	PrimerNombre         *string  `json:"empl_primer_nombre,omitempty"`
+	SegundoNombre        *string  `json:"empl_segundo_nombre,omitempty"`
+	LimpiarSegundoNombre bool     `json:"limpiar_segundo_nombre,omitempty"`
+	Email                *string  `json:"empl_email,omitempty"`
+	FechaNac             *string  `json:"empl_fecha_nac,omitempty"`
+	Sueldo               *float64 `json:"empl_sueldo,omitempty"`
+	Comision             *float64 `json:"empl_comision,omitempty"`
+	CargoID              *int     `json:"empl_cargo_id,omitempty"`
+	GerenteID            *int     `json:"empl_gerente_id,omitempty"`
+	LimpiarGerente       bool     `json:"limpiar_gerente,omitempty"`
+	DptoID               *int     `json:"empl_dpto_id,omitempty"`
+	Version              int      `json:"empl_version"`
+}
+
+type RestoreEmpleadoDTO struct {
+	ID      int `json:"empl_id"`
+	Version int `json:"empl_version"`
 }
 
 type SelectEmpleadoDTO struct {
@@ -30,7 +56,8 @@ type SelectEmpleadoDTO struct {
 }
 
 type DeleteEmpleadoDTO struct {
-	ID int `json:"empl_id"`
+	ID      int `json:"empl_id"`
+	Version int `json:"empl_version"`
 }
 
 type EmpleadoResponseDTO struct {
@@ -55,12 +82,118 @@ type EmpleadoDetailResponseDTO struct {
 	FechaNac           string  `json:"fecha_nac"`
 	Sueldo             float64 `json:"sueldo"`
 	Comision           float64 `json:"comision"`
+	CargoID            int     `json:"cargo_id"`
 	CargoNombre        string  `json:"cargo_nombre"`
+	GerenteID          *int    `json:"gerente_id"`
 	GerenteNombre      *string `json:"gerente_nombre"`
+	DptoID             int     `json:"dpto_id"`
 	DepartamentoNombre string  `json:"departamento_nombre"`
 	Direccion          string  `json:"direccion"`
 	Ciudad             string  `json:"ciudad"`
 	IsDeleted          bool    `json:"is_deleted"`
+	Version            int     `json:"version"`
+}
+
+// BatchRequestDTO agrupa operaciones que se ejecutan en una sola transacción.
+// Un paso con Ref puede ser referenciado por los siguientes: cualquier valor
+// de texto "$ref.campo" en Data se reemplaza por ese campo del resultado del
+// paso, p. ej. "$nuevo_gerente.empl_id".
+type BatchRequestDTO struct {
+	Steps []BatchStepDTO `json:"steps"`
+}
+
+type BatchStepDTO struct {
+	Ref       string `json:"ref,omitempty"`
+	Operation string `json:"operation"`
+	Data      any    `json:"data"`
+}
+
+type BatchResponseDTO struct {
+	Committed bool                 `json:"committed"`
+	Steps     []BatchStepResultDTO `json:"steps"`
+}
+
+type BatchStepResultDTO struct {
+	Index     int    `json:"index"`
+	Ref       string `json:"ref,omitempty"`
+	Operation string `json:"operation"`
+	Success   bool   `json:"success"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message"`
+	Data      any    `json:"data,omitempty"`
+}
+
+// ListAuditDTO filtra la auditoría. Las fechas usan el formato YYYY-MM-DD y
+// Hasta es inclusiva.
+type ListAuditDTO struct {
+	EmplID   *int   `json:"empl_id,omitempty"`
+	Operador string `json:"operador,omitempty"`
+	Desde    string `json:"desde,omitempty"`
+	Hasta    string `json:"hasta,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+}
+
+type AuditEntryDTO struct {
+	ID        int64           `json:"audit_id"`
+	Fecha     string          `json:"fecha"`
+	Operador  string          `json:"operador"`
+	Cliente   string          `json:"cliente"`
+	Operacion string          `json:"operacion"`
+	EmplID    *int            `json:"empl_id"`
+	Antes     json.RawMessage `json:"antes"`
+	Despues   json.RawMessage `json:"despues"`
+}
+
+type LoginDTO struct {
+	Usuario  string `json:"usuario"`
+	Password string `json:"password"`
+}
+
+type LoginResponseDTO struct {
+	Usuario            string `json:"usuario"`
+	Rol                string `json:"rol"`
+	Alcance            string `json:"alcance"`
+	EmplID             *int   `json:"empl_id"`
+	InactividadMaxSegs int    `json:"inactividad_max_segs"`
+	Token              string `json:"token,omitempty"` // solo en el gateway HTTP
+}
+
+type CreateUsuarioDTO struct {
+	Usuario  string `json:"usuario"`
+	Password string `json:"password"`
+	Rol      string `json:"rol"`
+	EmplID   *int   `json:"empl_id,omitempty"`
+}
+
+// UpdateUsuarioDTO cambia solo los campos enviados. DesvincularEmpleado
+// quita el empleado asociado al usuario.
+type UpdateUsuarioDTO struct {
+	Usuario             string  `json:"usuario"`
+	Password            *string `json:"password,omitempty"`
+	Rol                 *string `json:"rol,omitempty"`
+	Activo              *bool   `json:"activo,omitempty"`
+	EmplID              *int    `json:"empl_id,omitempty"`
+	DesvincularEmpleado bool    `json:"desvincular_empleado,omitempty"`
+}
+
+type UsuarioDTO struct {
+	ID      int    `json:"usr_id"`
+	Usuario string `json:"usuario"`
+	Rol     string `json:"rol"`
+	EmplID  *int   `json:"empl_id"`
+	Activo  bool   `json:"activo"`
+	Creado  string `json:"creado"`
+}
+
+type RolDTO struct {
+	Nombre      string   `json:"rol_nombre"`
+	Descripcion string   `json:"rol_descripcion"`
+	Alcance     string   `json:"rol_alcance"`
+	Permisos    []string `json:"permisos"`
+}
+
+type DeleteRolDTO struct {
+	Nombre string `json:"rol_nombre"`
 }
 
 type CargoDTO struct {
@@ -73,6 +206,15 @@ type DepartamentoDTO struct {
 	Nombre string `json:"dpto_nombre"`
 }
 
+// DepartamentoConDatosDTO es un departamento de LIST_DEPARTAMENTOS_CON_DATOS,
+// con su ubicación.
+type DepartamentoConDatosDTO struct {
+	ID        int    `json:"dpto_id"`
+	Nombre    string `json:"dpto_nombre"`
+	Direccion string `json:"direccion"`
+	Ciudad    string `json:"ciudad"`
+}
+
 type GerenteDTO struct {
 	ID     int    `json:"empl_id"`
 	Nombre string `json:"nombre_completo"`
@@ -90,6 +232,7 @@ type CreateEmpleadoResponseDTO struct {
 	Comision           float64 `json:"empl_comision"`
 	Direccion          string  `json:"direccion"`
 	Ciudad             string  `json:"ciudad"`
+	Version            int     `json:"empl_version"`
 }
 
 type UpdateEmpleadoResponseDTO struct {
@@ -104,6 +247,105 @@ type UpdateEmpleadoResponseDTO struct {
 	Comision           float64 `json:"empl_comision"`
 	Direccion          string  `json:"direccion"`
 	Ciudad             string  `json:"ciudad"`
+	Version            int     `json:"empl_version"`
+}
+
+// Tipos de evento de SUBSCRIBE: <entidad>.<cambio>.
+const (
+	EventoEmpleadoCreado          = "empleado.created"
+	EventoEmpleadoActualizado     = "empleado.updated"
+	EventoEmpleadoEliminado       = "empleado.deleted"
+	EventoEmpleadoRestaurado      = "empleado.restored"
+	EventoCargoCreado             = "cargo.created"
+	EventoCargoActualizado        = "cargo.updated"
+	EventoCargoEliminado          = "cargo.deleted"
+	EventoDepartamentoCreado      = "departamento.created"
+	EventoDepartamentoActualizado = "departamento.updated"
+	EventoDepartamentoEliminado   = "departamento.deleted"
+)
+
+// SubscribeDTO filtra los eventos de SUBSCRIBE. Tipos acepta un tipo exacto
+// ("empleado.created") o una entidad ("empleado" o "empleado.*"); vacío
+// recibe todos. Con DptoIDs solo llegan eventos de esos departamentos,
+// incluido el departamento anterior de un empleado trasladado.
+type SubscribeDTO struct {
+	Tipos   []string `json:"tipos,omitempty"`
+	DptoIDs []int    `json:"dpto_ids,omitempty"`
+}
+
+// EventoDTO es un cambio publicado a los suscriptores. Lleva solo
+// identificadores; el detalle se consulta con SELECT o LIST_AUDIT.
+type EventoDTO struct {
+	Tipo           string `json:"tipo"`
+	ID             *int   `json:"id"`
+	DptoID         *int   `json:"dpto_id,omitempty"`
+	DptoAnteriorID *int   `json:"dpto_anterior_id,omitempty"`
+	AuditID        *int64 `json:"audit_id,omitempty"`
+	Operador       string `json:"operador"`
+	Fecha          string `json:"fecha"`
+}
+
+// CreateWebhookDTO registra un webhook. Tipos acepta los mismos valores que
+// SubscribeDTO.Tipos; vacío recibe todos los eventos. Secreto firma las
+// entregas con HMAC-SHA256 y no se devuelve en las consultas.
+type CreateWebhookDTO struct {
+	URL     string   `json:"url"`
+	Tipos   []string `json:"tipos,omitempty"`
+	Secreto string   `json:"secreto"`
+}
+
+type WebhookDTO struct {
+	ID     int      `json:"wh_id"`
+	URL    string   `json:"url"`
+	Tipos  []string `json:"tipos"`
+	Activo bool     `json:"activo"`
+	Creado string   `json:"creado"`
+}
+
+// UpdateWebhookDTO activa o desactiva un webhook.
+type UpdateWebhookDTO struct {
+	ID     int   `json:"wh_id"`
+	Activo *bool `json:"activo"`
+}
+
+type DeleteWebhookDTO struct {
+	ID int `json:"wh_id"`
+}
+
+// EntregaWebhookDTO es una entrega que agotó los reintentos.
+type EntregaWebhookDTO struct {
+	ID          int64           `json:"entrega_id"`
+	WebhookID   int             `json:"wh_id"`
+	URL         string          `json:"url"`
+	Tipo        string          `json:"tipo"`
+	Intentos    int             `json:"intentos"`
+	UltimoError string          `json:"ultimo_error"`
+	Creado      string          `json:"creado"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+type RetryEntregaDTO struct {
+	ID int64 `json:"entrega_id"`
+}
+
+// HealthDTO es el estado del servidor que informan PING y HEALTH. Vivo
+// indica que el proceso atiende peticiones; Listo, que además puede operar:
+// terminó de arrancar, no se está apagando, la base de datos responde y el
+// esquema no tiene migraciones pendientes. Problemas explica por qué no está
+// listo. PING solo informa los campos que no consultan la base de datos.
+type HealthDTO struct {
+	Vivo                  bool     `json:"vivo"`
+	Listo                 bool     `json:"listo"`
+	Problemas             []string `json:"problemas,omitempty"`
+	Uptime                string   `json:"uptime"`
+	UptimeSegundos        int64    `json:"uptime_segs"`
+	ConexionesAbiertas    int      `json:"conexiones_abiertas"`
+	Apagando              bool     `json:"apagando"`
+	BaseDatos             string   `json:"base_datos,omitempty"`
+	BaseDatosOK           bool     `json:"base_datos_ok"`
+	ConexionesBD          int      `json:"conexiones_bd"`
+	VersionEsquema        int      `json:"version_esquema"`
+	MigracionesPendientes int      `json:"migraciones_pendientes"`
 }
 
 type Request struct {
@@ -111,8 +353,31 @@ type Request struct {
 	Data      any    `json:"data"`
 }
 
+// Códigos de error del protocolo. Response.Code queda vacío en las
+// respuestas exitosas y en errores sin un código específico.
+const (
+	CodeConflict     = "CONFLICT"
+	CodeUnauthorized = "UNAUTHORIZED"
+	CodeForbidden    = "FORBIDDEN"
+	CodeNotFound     = "NOT_FOUND"
+	// CodeTimeout indica que la operación no terminó dentro de su plazo y
+	// se canceló; si modificaba datos, la transacción se revirtió.
+	CodeTimeout = "TIMEOUT"
+	// CodeShuttingDown es el aviso que el servidor envía antes de cerrar
+	// una conexión porque se está apagando. No responde a ninguna petición
+	// pendiente: las que estaban en curso ya recibieron su respuesta.
+	CodeShuttingDown = "SHUTTING_DOWN"
+	// CodeRateLimited indica que el cliente excedió un límite del servidor:
+	// peticiones por segundo, tamaño de la petición o conexiones abiertas.
+	CodeRateLimited = "RATE_LIMITED"
+	// CodeInternal indica un fallo del servidor, como una consulta fallida
+	// o la base de datos no disponible, y no un error en la petición.
+	CodeInternal = "INTERNAL"
+)
+
 type Response struct {
 	Success bool   `json:"success"`
+	Code    string `json:"code,omitempty"`
 	Message string `json:"message"`
 	Data    any    `json:"data,omitempty"`
 }
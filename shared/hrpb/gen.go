@@ -0,0 +1,6 @@
+// Package hrpb contiene el código generado a partir de hr.proto, el servicio
+// gRPC del sistema de recursos humanos. Los clientes de otros lenguajes
+// generan sus stubs desde el mismo archivo.
+package hrpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative hr.proto
@@ -0,0 +1,4357 @@
+// Servicio gRPC equivalente al protocolo de socket. Cada RPC ejecuta la
+// operación del mismo nombre de processRequest, con la misma autenticación,
+// permisos, alcance y enmascarado. Los nombres de campo coinciden con las
+// claves JSON de los DTOs de shared. UNSUBSCRIBE no tiene RPC: la
+// suscripción termina al cancelar el stream de Subscribe.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: hr.proto
+
+package hrpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usuario  string `protobuf:"bytes,1,opt,name=usuario,proto3" json:"usuario,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoginRequest) GetUsuario() string {
+	if x != nil {
+		return x.Usuario
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usuario            string `protobuf:"bytes,1,opt,name=usuario,proto3" json:"usuario,omitempty"`
+	Rol                string `protobuf:"bytes,2,opt,name=rol,proto3" json:"rol,omitempty"`
+	Alcance            string `protobuf:"bytes,3,opt,name=alcance,proto3" json:"alcance,omitempty"`
+	EmplId             *int32 `protobuf:"varint,4,opt,name=empl_id,json=emplId,proto3,oneof" json:"empl_id,omitempty"`
+	InactividadMaxSegs int32  `protobuf:"varint,5,opt,name=inactividad_max_segs,json=inactividadMaxSegs,proto3" json:"inactividad_max_segs,omitempty"`
+	Token              string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginResponse) GetUsuario() string {
+	if x != nil {
+		return x.Usuario
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRol() string {
+	if x != nil {
+		return x.Rol
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetAlcance() string {
+	if x != nil {
+		return x.Alcance
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetEmplId() int32 {
+	if x != nil && x.EmplId != nil {
+		return *x.EmplId
+	}
+	return 0
+}
+
+func (x *LoginResponse) GetInactividadMaxSegs() int32 {
+	if x != nil {
+		return x.InactividadMaxSegs
+	}
+	return 0
+}
+
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CreateEmpleado struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplPrimerNombre  string  `protobuf:"bytes,1,opt,name=empl_primer_nombre,json=emplPrimerNombre,proto3" json:"empl_primer_nombre,omitempty"`
+	EmplSegundoNombre *string `protobuf:"bytes,2,opt,name=empl_segundo_nombre,json=emplSegundoNombre,proto3,oneof" json:"empl_segundo_nombre,omitempty"`
+	EmplEmail         string  `protobuf:"bytes,3,opt,name=empl_email,json=emplEmail,proto3" json:"empl_email,omitempty"`
+	EmplFechaNac      string  `protobuf:"bytes,4,opt,name=empl_fecha_nac,json=emplFechaNac,proto3" json:"empl_fecha_nac,omitempty"`
+	EmplSueldo        float64 `protobuf:"fixed64,5,opt,name=empl_sueldo,json=emplSueldo,proto3" json:"empl_sueldo,omitempty"`
+	EmplComision      float64 `protobuf:"fixed64,6,opt,name=empl_comision,json=emplComision,proto3" json:"empl_comision,omitempty"`
+	EmplCargoId       int32   `protobuf:"varint,7,opt,name=empl_cargo_id,json=emplCargoId,proto3" json:"empl_cargo_id,omitempty"`
+	EmplGerenteId     *int32  `protobuf:"varint,8,opt,name=empl_gerente_id,json=emplGerenteId,proto3,oneof" json:"empl_gerente_id,omitempty"`
+	EmplDptoId        int32   `protobuf:"varint,9,opt,name=empl_dpto_id,json=emplDptoId,proto3" json:"empl_dpto_id,omitempty"`
+}
+
+func (x *CreateEmpleado) Reset() {
+	*x = CreateEmpleado{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmpleado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmpleado) ProtoMessage() {}
+
+func (x *CreateEmpleado) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmpleado.ProtoReflect.Descriptor instead.
+func (*CreateEmpleado) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateEmpleado) GetEmplPrimerNombre() string {
+	if x != nil {
+		return x.EmplPrimerNombre
+	}
+	return ""
+}
+
+func (x *CreateEmpleado) GetEmplSegundoNombre() string {
+	if x != nil && x.EmplSegundoNombre != nil {
+		return *x.EmplSegundoNombre
+	}
+	return ""
+}
+
+func (x *CreateEmpleado) GetEmplEmail() string {
+	if x != nil {
+		return x.EmplEmail
+	}
+	return ""
+}
+
+func (x *CreateEmpleado) GetEmplFechaNac() string {
+	if x != nil {
+		return x.EmplFechaNac
+	}
+	return ""
+}
+
+func (x *CreateEmpleado) GetEmplSueldo() float64 {
+	if x != nil {
+		return x.EmplSueldo
+	}
+	return 0
+}
+
+func (x *CreateEmpleado) GetEmplComision() float64 {
+	if x != nil {
+		return x.EmplComision
+	}
+	return 0
+}
+
+func (x *CreateEmpleado) GetEmplCargoId() int32 {
+	if x != nil {
+		return x.EmplCargoId
+	}
+	return 0
+}
+
+func (x *CreateEmpleado) GetEmplGerenteId() int32 {
+	if x != nil && x.EmplGerenteId != nil {
+		return *x.EmplGerenteId
+	}
+	return 0
+}
+
+func (x *CreateEmpleado) GetEmplDptoId() int32 {
+	if x != nil {
+		return x.EmplDptoId
+	}
+	return 0
+}
+
+type UpdateEmpleado struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId            int32   `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+	EmplPrimerNombre  string  `protobuf:"bytes,2,opt,name=empl_primer_nombre,json=emplPrimerNombre,proto3" json:"empl_primer_nombre,omitempty"`
+	EmplSegundoNombre *string `protobuf:"bytes,3,opt,name=empl_segundo_nombre,json=emplSegundoNombre,proto3,oneof" json:"empl_segundo_nombre,omitempty"`
+	EmplEmail         string  `protobuf:"bytes,4,opt,name=empl_email,json=emplEmail,proto3" json:"empl_email,omitempty"`
+	EmplFechaNac      string  `protobuf:"bytes,5,opt,name=empl_fecha_nac,json=emplFechaNac,proto3" json:"empl_fecha_nac,omitempty"`
+	EmplSueldo        float64 `protobuf:"fixed64,6,opt,name=empl_sueldo,json=emplSueldo,proto3" json:"empl_sueldo,omitempty"`
+	EmplComision      float64 `protobuf:"fixed64,7,opt,name=empl_comision,json=emplComision,proto3" json:"empl_comision,omitempty"`
+	EmplCargoId       int32   `protobuf:"varint,8,opt,name=empl_cargo_id,json=emplCargoId,proto3" json:"empl_cargo_id,omitempty"`
+	EmplGerenteId     *int32  `protobuf:"varint,9,opt,name=empl_gerente_id,json=emplGerenteId,proto3,oneof" json:"empl_gerente_id,omitempty"`
+	EmplDptoId        int32   `protobuf:"varint,10,opt,name=empl_dpto_id,json=emplDptoId,proto3" json:"empl_dpto_id,omitempty"`
+	EmplVersion       int32   `protobuf:"varint,11,opt,name=empl_version,json=emplVersion,proto3" json:"empl_version,omitempty"`
+}
+
+func (x *UpdateEmpleado) Reset() {
+	*x = UpdateEmpleado{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEmpleado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmpleado) ProtoMessage() {}
+
+func (x *UpdateEmpleado) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmpleado.ProtoReflect.Descriptor instead.
+func (*UpdateEmpleado) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateEmpleado) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+func (x *UpdateEmpleado) GetEmplPrimerNombre() string {
+	if x != nil {
+		return x.EmplPrimerNombre
+	}
+	return ""
+}
+
+func (x *UpdateEmpleado) GetEmplSegundoNombre() string {
+	if x != nil && x.EmplSegundoNombre != nil {
+		return *x.EmplSegundoNombre
+	}
+	return ""
+}
+
+func (x *UpdateEmpleado) GetEmplEmail() string {
+	if x != nil {
+		return x.EmplEmail
+	}
+	return ""
+}
+
+func (x *UpdateEmpleado) GetEmplFechaNac() string {
+	if x != nil {
+		return x.EmplFechaNac
+	}
+	return ""
+}
+
+func (x *UpdateEmpleado) GetEmplSueldo() float64 {
+	if x != nil {
+		return x.EmplSueldo
+	}
+	return 0
+}
+
+func (x *UpdateEmpleado) GetEmplComision() float64 {
+	if x != nil {
+		return x.EmplComision
+	}
+	return 0
+}
+
+func (x *UpdateEmpleado) GetEmplCargoId() int32 {
+	if x != nil {
+		return x.EmplCargoId
+	}
+	return 0
+}
+
+func (x *UpdateEmpleado) GetEmplGerenteId() int32 {
+	if x != nil && x.EmplGerenteId != nil {
+		return *x.EmplGerenteId
+	}
+	return 0
+}
+
+func (x *UpdateEmpleado) GetEmplDptoId() int32 {
+	if x != nil {
+		return x.EmplDptoId
+	}
+	return 0
+}
+
+func (x *UpdateEmpleado) GetEmplVersion() int32 {
+	if x != nil {
+		return x.EmplVersion
+	}
+	return 0
+}
+
+type PatchEmpleado struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId               int32    `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+	EmplPrimerNombre     *string  `protobuf:"bytes,2,opt,name=empl_primer_nombre,json=emplPrimerNombre,proto3,oneof" json:"empl_primer_nombre,omitempty"`
+	EmplSegundoNombre    *string  `protobuf:"bytes,3,opt,name=empl_segundo_nombre,json=emplSegundoNombre,proto3,oneof" json:"empl_segundo_nombre,omitempty"`
+	LimpiarSegundoNombre bool     `protobuf:"varint,4,opt,name=limpiar_segundo_nombre,json=limpiarSegundoNombre,proto3" json:"limpiar_segundo_nombre,omitempty"`
+	EmplEmail            *string  `protobuf:"bytes,5,opt,name=empl_email,json=emplEmail,proto3,oneof" json:"empl_email,omitempty"`
+	EmplFechaNac         *string  `protobuf:"bytes,6,opt,name=empl_fecha_nac,json=emplFechaNac,proto3,oneof" json:"empl_fecha_nac,omitempty"`
+	EmplSueldo           *float64 `protobuf:"fixed64,7,opt,name=empl_sueldo,json=emplSueldo,proto3,oneof" json:"empl_sueldo,omitempty"`
+	EmplComision         *float64 `protobuf:"fixed64,8,opt,name=empl_comision,json=emplComision,proto3,oneof" json:"empl_comision,omitempty"`
+	EmplCargoId          *int32   `protobuf:"varint,9,opt,name=empl_cargo_id,json=emplCargoId,proto3,oneof" json:"empl_cargo_id,omitempty"`
+	EmplGerenteId        *int32   `protobuf:"varint,10,opt,name=empl_gerente_id,json=emplGerenteId,proto3,oneof" json:"empl_gerente_id,omitempty"`
+	LimpiarGerente       bool     `protobuf:"varint,11,opt,name=limpiar_gerente,json=limpiarGerente,proto3" json:"limpiar_gerente,omitempty"`
+	EmplDptoId           *int32   `protobuf:"varint,12,opt,name=empl_dpto_id,json=emplDptoId,proto3,oneof" json:"empl_dpto_id,omitempty"`
+	EmplVersion          int32    `protobuf:"varint,13,opt,name=empl_version,json=emplVersion,proto3" json:"empl_version,omitempty"`
+}
+
+func (x *PatchEmpleado) Reset() {
+	*x = PatchEmpleado{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchEmpleado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchEmpleado) ProtoMessage() {}
+
+func (x *PatchEmpleado) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchEmpleado.ProtoReflect.Descriptor instead.
+func (*PatchEmpleado) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PatchEmpleado) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+func (x *PatchEmpleado) GetEmplPrimerNombre() string {
+	if x != nil && x.EmplPrimerNombre != nil {
+		return *x.EmplPrimerNombre
+	}
+	return ""
+}
+
+func (x *PatchEmpleado) GetEmplSegundoNombre() string {
+	if x != nil && x.EmplSegundoNombre != nil {
+		return *x.EmplSegundoNombre
+	}
+	return ""
+}
+
+func (x *PatchEmpleado) GetLimpiarSegundoNombre() bool {
+	if x != nil {
+		return x.LimpiarSegundoNombre
+	}
+	return false
+}
+
+func (x *PatchEmpleado) GetEmplEmail() string {
+	if x != nil && x.EmplEmail != nil {
+		return *x.EmplEmail
+	}
+	return ""
+}
+
+func (x *PatchEmpleado) GetEmplFechaNac() string {
+	if x != nil && x.EmplFechaNac != nil {
+		return *x.EmplFechaNac
+	}
+	return ""
+}
+
+func (x *PatchEmpleado) GetEmplSueldo() float64 {
+	if x != nil && x.EmplSueldo != nil {
+		return *x.EmplSueldo
+	}
+	return 0
+}
+
+func (x *PatchEmpleado) GetEmplComision() float64 {
+	if x != nil && x.EmplComision != nil {
+		return *x.EmplComision
+	}
+	return 0
+}
+
+func (x *PatchEmpleado) GetEmplCargoId() int32 {
+	if x != nil && x.EmplCargoId != nil {
+		return *x.EmplCargoId
+	}
+	return 0
+}
+
+func (x *PatchEmpleado) GetEmplGerenteId() int32 {
+	if x != nil && x.EmplGerenteId != nil {
+		return *x.EmplGerenteId
+	}
+	return 0
+}
+
+func (x *PatchEmpleado) GetLimpiarGerente() bool {
+	if x != nil {
+		return x.LimpiarGerente
+	}
+	return false
+}
+
+func (x *PatchEmpleado) GetEmplDptoId() int32 {
+	if x != nil && x.EmplDptoId != nil {
+		return *x.EmplDptoId
+	}
+	return 0
+}
+
+func (x *PatchEmpleado) GetEmplVersion() int32 {
+	if x != nil {
+		return x.EmplVersion
+	}
+	return 0
+}
+
+type SelectEmpleado struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId int32 `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+}
+
+func (x *SelectEmpleado) Reset() {
+	*x = SelectEmpleado{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectEmpleado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectEmpleado) ProtoMessage() {}
+
+func (x *SelectEmpleado) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectEmpleado.ProtoReflect.Descriptor instead.
+func (*SelectEmpleado) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SelectEmpleado) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+type DeleteEmpleado struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId      int32 `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+	EmplVersion int32 `protobuf:"varint,2,opt,name=empl_version,json=emplVersion,proto3" json:"empl_version,omitempty"`
+}
+
+func (x *DeleteEmpleado) Reset() {
+	*x = DeleteEmpleado{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEmpleado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmpleado) ProtoMessage() {}
+
+func (x *DeleteEmpleado) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmpleado.ProtoReflect.Descriptor instead.
+func (*DeleteEmpleado) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteEmpleado) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+func (x *DeleteEmpleado) GetEmplVersion() int32 {
+	if x != nil {
+		return x.EmplVersion
+	}
+	return 0
+}
+
+type RestoreEmpleado struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId      int32 `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+	EmplVersion int32 `protobuf:"varint,2,opt,name=empl_version,json=emplVersion,proto3" json:"empl_version,omitempty"`
+}
+
+func (x *RestoreEmpleado) Reset() {
+	*x = RestoreEmpleado{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEmpleado) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEmpleado) ProtoMessage() {}
+
+func (x *RestoreEmpleado) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEmpleado.ProtoReflect.Descriptor instead.
+func (*RestoreEmpleado) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestoreEmpleado) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+func (x *RestoreEmpleado) GetEmplVersion() int32 {
+	if x != nil {
+		return x.EmplVersion
+	}
+	return 0
+}
+
+// Los campos de compensación y datos personales llegan vacíos si el rol no
+// tiene permiso para verlos.
+type EmpleadoDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PrimerNombre       string   `protobuf:"bytes,2,opt,name=primer_nombre,json=primerNombre,proto3" json:"primer_nombre,omitempty"`
+	SegundoNombre      *string  `protobuf:"bytes,3,opt,name=segundo_nombre,json=segundoNombre,proto3,oneof" json:"segundo_nombre,omitempty"`
+	Email              *string  `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	FechaNac           *string  `protobuf:"bytes,5,opt,name=fecha_nac,json=fechaNac,proto3,oneof" json:"fecha_nac,omitempty"`
+	Sueldo             *float64 `protobuf:"fixed64,6,opt,name=sueldo,proto3,oneof" json:"sueldo,omitempty"`
+	Comision           *float64 `protobuf:"fixed64,7,opt,name=comision,proto3,oneof" json:"comision,omitempty"`
+	CargoId            int32    `protobuf:"varint,8,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	CargoNombre        string   `protobuf:"bytes,9,opt,name=cargo_nombre,json=cargoNombre,proto3" json:"cargo_nombre,omitempty"`
+	GerenteId          *int32   `protobuf:"varint,10,opt,name=gerente_id,json=gerenteId,proto3,oneof" json:"gerente_id,omitempty"`
+	GerenteNombre      *string  `protobuf:"bytes,11,opt,name=gerente_nombre,json=gerenteNombre,proto3,oneof" json:"gerente_nombre,omitempty"`
+	DptoId             int32    `protobuf:"varint,12,opt,name=dpto_id,json=dptoId,proto3" json:"dpto_id,omitempty"`
+	DepartamentoNombre string   `protobuf:"bytes,13,opt,name=departamento_nombre,json=departamentoNombre,proto3" json:"departamento_nombre,omitempty"`
+	Direccion          string   `protobuf:"bytes,14,opt,name=direccion,proto3" json:"direccion,omitempty"`
+	Ciudad             string   `protobuf:"bytes,15,opt,name=ciudad,proto3" json:"ciudad,omitempty"`
+	IsDeleted          bool     `protobuf:"varint,16,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	Version            int32    `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *EmpleadoDetail) Reset() {
+	*x = EmpleadoDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmpleadoDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmpleadoDetail) ProtoMessage() {}
+
+func (x *EmpleadoDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmpleadoDetail.ProtoReflect.Descriptor instead.
+func (*EmpleadoDetail) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EmpleadoDetail) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmpleadoDetail) GetPrimerNombre() string {
+	if x != nil {
+		return x.PrimerNombre
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetSegundoNombre() string {
+	if x != nil && x.SegundoNombre != nil {
+		return *x.SegundoNombre
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetFechaNac() string {
+	if x != nil && x.FechaNac != nil {
+		return *x.FechaNac
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetSueldo() float64 {
+	if x != nil && x.Sueldo != nil {
+		return *x.Sueldo
+	}
+	return 0
+}
+
+func (x *EmpleadoDetail) GetComision() float64 {
+	if x != nil && x.Comision != nil {
+		return *x.Comision
+	}
+	return 0
+}
+
+func (x *EmpleadoDetail) GetCargoId() int32 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *EmpleadoDetail) GetCargoNombre() string {
+	if x != nil {
+		return x.CargoNombre
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetGerenteId() int32 {
+	if x != nil && x.GerenteId != nil {
+		return *x.GerenteId
+	}
+	return 0
+}
+
+func (x *EmpleadoDetail) GetGerenteNombre() string {
+	if x != nil && x.GerenteNombre != nil {
+		return *x.GerenteNombre
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetDptoId() int32 {
+	if x != nil {
+		return x.DptoId
+	}
+	return 0
+}
+
+func (x *EmpleadoDetail) GetDepartamentoNombre() string {
+	if x != nil {
+		return x.DepartamentoNombre
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetDireccion() string {
+	if x != nil {
+		return x.Direccion
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetCiudad() string {
+	if x != nil {
+		return x.Ciudad
+	}
+	return ""
+}
+
+func (x *EmpleadoDetail) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *EmpleadoDetail) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CreateEmpleadoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId             int32    `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+	EmplPrimerNombre   string   `protobuf:"bytes,2,opt,name=empl_primer_nombre,json=emplPrimerNombre,proto3" json:"empl_primer_nombre,omitempty"`
+	EmplSegundoNombre  *string  `protobuf:"bytes,3,opt,name=empl_segundo_nombre,json=emplSegundoNombre,proto3,oneof" json:"empl_segundo_nombre,omitempty"`
+	EmplFechaNac       *string  `protobuf:"bytes,4,opt,name=empl_fecha_nac,json=emplFechaNac,proto3,oneof" json:"empl_fecha_nac,omitempty"`
+	CargoNombre        string   `protobuf:"bytes,5,opt,name=cargo_nombre,json=cargoNombre,proto3" json:"cargo_nombre,omitempty"`
+	DepartamentoNombre string   `protobuf:"bytes,6,opt,name=departamento_nombre,json=departamentoNombre,proto3" json:"departamento_nombre,omitempty"`
+	GerenteNombre      *string  `protobuf:"bytes,7,opt,name=gerente_nombre,json=gerenteNombre,proto3,oneof" json:"gerente_nombre,omitempty"`
+	EmplSueldo         *float64 `protobuf:"fixed64,8,opt,name=empl_sueldo,json=emplSueldo,proto3,oneof" json:"empl_sueldo,omitempty"`
+	EmplComision       *float64 `protobuf:"fixed64,9,opt,name=empl_comision,json=emplComision,proto3,oneof" json:"empl_comision,omitempty"`
+	Direccion          string   `protobuf:"bytes,10,opt,name=direccion,proto3" json:"direccion,omitempty"`
+	Ciudad             string   `protobuf:"bytes,11,opt,name=ciudad,proto3" json:"ciudad,omitempty"`
+	EmplVersion        int32    `protobuf:"varint,12,opt,name=empl_version,json=emplVersion,proto3" json:"empl_version,omitempty"`
+}
+
+func (x *CreateEmpleadoResponse) Reset() {
+	*x = CreateEmpleadoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmpleadoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmpleadoResponse) ProtoMessage() {}
+
+func (x *CreateEmpleadoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmpleadoResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmpleadoResponse) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateEmpleadoResponse) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+func (x *CreateEmpleadoResponse) GetEmplPrimerNombre() string {
+	if x != nil {
+		return x.EmplPrimerNombre
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetEmplSegundoNombre() string {
+	if x != nil && x.EmplSegundoNombre != nil {
+		return *x.EmplSegundoNombre
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetEmplFechaNac() string {
+	if x != nil && x.EmplFechaNac != nil {
+		return *x.EmplFechaNac
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetCargoNombre() string {
+	if x != nil {
+		return x.CargoNombre
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetDepartamentoNombre() string {
+	if x != nil {
+		return x.DepartamentoNombre
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetGerenteNombre() string {
+	if x != nil && x.GerenteNombre != nil {
+		return *x.GerenteNombre
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetEmplSueldo() float64 {
+	if x != nil && x.EmplSueldo != nil {
+		return *x.EmplSueldo
+	}
+	return 0
+}
+
+func (x *CreateEmpleadoResponse) GetEmplComision() float64 {
+	if x != nil && x.EmplComision != nil {
+		return *x.EmplComision
+	}
+	return 0
+}
+
+func (x *CreateEmpleadoResponse) GetDireccion() string {
+	if x != nil {
+		return x.Direccion
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetCiudad() string {
+	if x != nil {
+		return x.Ciudad
+	}
+	return ""
+}
+
+func (x *CreateEmpleadoResponse) GetEmplVersion() int32 {
+	if x != nil {
+		return x.EmplVersion
+	}
+	return 0
+}
+
+type UpdateEmpleadoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId             int32    `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+	EmplPrimerNombre   string   `protobuf:"bytes,2,opt,name=empl_primer_nombre,json=emplPrimerNombre,proto3" json:"empl_primer_nombre,omitempty"`
+	EmplSegundoNombre  *string  `protobuf:"bytes,3,opt,name=empl_segundo_nombre,json=emplSegundoNombre,proto3,oneof" json:"empl_segundo_nombre,omitempty"`
+	EmplFechaNac       *string  `protobuf:"bytes,4,opt,name=empl_fecha_nac,json=emplFechaNac,proto3,oneof" json:"empl_fecha_nac,omitempty"`
+	CargoNombre        string   `protobuf:"bytes,5,opt,name=cargo_nombre,json=cargoNombre,proto3" json:"cargo_nombre,omitempty"`
+	DepartamentoNombre string   `protobuf:"bytes,6,opt,name=departamento_nombre,json=departamentoNombre,proto3" json:"departamento_nombre,omitempty"`
+	GerenteNombre      *string  `protobuf:"bytes,7,opt,name=gerente_nombre,json=gerenteNombre,proto3,oneof" json:"gerente_nombre,omitempty"`
+	EmplSueldo         *float64 `protobuf:"fixed64,8,opt,name=empl_sueldo,json=emplSueldo,proto3,oneof" json:"empl_sueldo,omitempty"`
+	EmplComision       *float64 `protobuf:"fixed64,9,opt,name=empl_comision,json=emplComision,proto3,oneof" json:"empl_comision,omitempty"`
+	Direccion          string   `protobuf:"bytes,10,opt,name=direccion,proto3" json:"direccion,omitempty"`
+	Ciudad             string   `protobuf:"bytes,11,opt,name=ciudad,proto3" json:"ciudad,omitempty"`
+	EmplVersion        int32    `protobuf:"varint,12,opt,name=empl_version,json=emplVersion,proto3" json:"empl_version,omitempty"`
+}
+
+func (x *UpdateEmpleadoResponse) Reset() {
+	*x = UpdateEmpleadoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEmpleadoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmpleadoResponse) ProtoMessage() {}
+
+func (x *UpdateEmpleadoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmpleadoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmpleadoResponse) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateEmpleadoResponse) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+func (x *UpdateEmpleadoResponse) GetEmplPrimerNombre() string {
+	if x != nil {
+		return x.EmplPrimerNombre
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetEmplSegundoNombre() string {
+	if x != nil && x.EmplSegundoNombre != nil {
+		return *x.EmplSegundoNombre
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetEmplFechaNac() string {
+	if x != nil && x.EmplFechaNac != nil {
+		return *x.EmplFechaNac
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetCargoNombre() string {
+	if x != nil {
+		return x.CargoNombre
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetDepartamentoNombre() string {
+	if x != nil {
+		return x.DepartamentoNombre
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetGerenteNombre() string {
+	if x != nil && x.GerenteNombre != nil {
+		return *x.GerenteNombre
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetEmplSueldo() float64 {
+	if x != nil && x.EmplSueldo != nil {
+		return *x.EmplSueldo
+	}
+	return 0
+}
+
+func (x *UpdateEmpleadoResponse) GetEmplComision() float64 {
+	if x != nil && x.EmplComision != nil {
+		return *x.EmplComision
+	}
+	return 0
+}
+
+func (x *UpdateEmpleadoResponse) GetDireccion() string {
+	if x != nil {
+		return x.Direccion
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetCiudad() string {
+	if x != nil {
+		return x.Ciudad
+	}
+	return ""
+}
+
+func (x *UpdateEmpleadoResponse) GetEmplVersion() int32 {
+	if x != nil {
+		return x.EmplVersion
+	}
+	return 0
+}
+
+// BatchStep.data usa los mismos campos que el mensaje de la operación; los
+// valores "$ref.campo" se resuelven con el resultado de un paso anterior.
+type BatchStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref       string           `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Operation string           `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Data      *structpb.Struct `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BatchStep) Reset() {
+	*x = BatchStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStep) ProtoMessage() {}
+
+func (x *BatchStep) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStep.ProtoReflect.Descriptor instead.
+func (*BatchStep) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchStep) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *BatchStep) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BatchStep) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*BatchStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchRequest) GetSteps() []*BatchStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type BatchStepResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     int32           `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Ref       string          `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Operation string          `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Success   bool            `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Code      string          `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Message   string          `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Data      *structpb.Value `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BatchStepResult) Reset() {
+	*x = BatchStepResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStepResult) ProtoMessage() {}
+
+func (x *BatchStepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStepResult.ProtoReflect.Descriptor instead.
+func (*BatchStepResult) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchStepResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchStepResult) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *BatchStepResult) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BatchStepResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchStepResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchStepResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchStepResult) GetData() *structpb.Value {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Committed bool               `protobuf:"varint,1,opt,name=committed,proto3" json:"committed,omitempty"`
+	Steps     []*BatchStepResult `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *BatchResponse) GetSteps() []*BatchStepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type ListAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId   *int32 `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3,oneof" json:"empl_id,omitempty"`
+	Operador string `protobuf:"bytes,2,opt,name=operador,proto3" json:"operador,omitempty"`
+	Desde    string `protobuf:"bytes,3,opt,name=desde,proto3" json:"desde,omitempty"`
+	Hasta    string `protobuf:"bytes,4,opt,name=hasta,proto3" json:"hasta,omitempty"`
+	Limit    int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListAuditRequest) Reset() {
+	*x = ListAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditRequest) ProtoMessage() {}
+
+func (x *ListAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAuditRequest) GetEmplId() int32 {
+	if x != nil && x.EmplId != nil {
+		return *x.EmplId
+	}
+	return 0
+}
+
+func (x *ListAuditRequest) GetOperador() string {
+	if x != nil {
+		return x.Operador
+	}
+	return ""
+}
+
+func (x *ListAuditRequest) GetDesde() string {
+	if x != nil {
+		return x.Desde
+	}
+	return ""
+}
+
+func (x *ListAuditRequest) GetHasta() string {
+	if x != nil {
+		return x.Hasta
+	}
+	return ""
+}
+
+func (x *ListAuditRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuditId   int64            `protobuf:"varint,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	Fecha     string           `protobuf:"bytes,2,opt,name=fecha,proto3" json:"fecha,omitempty"`
+	Operador  string           `protobuf:"bytes,3,opt,name=operador,proto3" json:"operador,omitempty"`
+	Cliente   string           `protobuf:"bytes,4,opt,name=cliente,proto3" json:"cliente,omitempty"`
+	Operacion string           `protobuf:"bytes,5,opt,name=operacion,proto3" json:"operacion,omitempty"`
+	EmplId    *int32           `protobuf:"varint,6,opt,name=empl_id,json=emplId,proto3,oneof" json:"empl_id,omitempty"`
+	Antes     *structpb.Struct `protobuf:"bytes,7,opt,name=antes,proto3" json:"antes,omitempty"`
+	Despues   *structpb.Struct `protobuf:"bytes,8,opt,name=despues,proto3" json:"despues,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuditEntry) GetAuditId() int64 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetFecha() string {
+	if x != nil {
+		return x.Fecha
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOperador() string {
+	if x != nil {
+		return x.Operador
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCliente() string {
+	if x != nil {
+		return x.Cliente
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOperacion() string {
+	if x != nil {
+		return x.Operacion
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEmplId() int32 {
+	if x != nil && x.EmplId != nil {
+		return *x.EmplId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAntes() *structpb.Struct {
+	if x != nil {
+		return x.Antes
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetDespues() *structpb.Struct {
+	if x != nil {
+		return x.Despues
+	}
+	return nil
+}
+
+type Cargo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoId     int32  `protobuf:"varint,1,opt,name=cargo_id,json=cargoId,proto3" json:"cargo_id,omitempty"`
+	CargoNombre string `protobuf:"bytes,2,opt,name=cargo_nombre,json=cargoNombre,proto3" json:"cargo_nombre,omitempty"`
+}
+
+func (x *Cargo) Reset() {
+	*x = Cargo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cargo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cargo) ProtoMessage() {}
+
+func (x *Cargo) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cargo.ProtoReflect.Descriptor instead.
+func (*Cargo) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Cargo) GetCargoId() int32 {
+	if x != nil {
+		return x.CargoId
+	}
+	return 0
+}
+
+func (x *Cargo) GetCargoNombre() string {
+	if x != nil {
+		return x.CargoNombre
+	}
+	return ""
+}
+
+type CargoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cargos []*Cargo `protobuf:"bytes,1,rep,name=cargos,proto3" json:"cargos,omitempty"`
+}
+
+func (x *CargoList) Reset() {
+	*x = CargoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CargoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CargoList) ProtoMessage() {}
+
+func (x *CargoList) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CargoList.ProtoReflect.Descriptor instead.
+func (*CargoList) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CargoList) GetCargos() []*Cargo {
+	if x != nil {
+		return x.Cargos
+	}
+	return nil
+}
+
+type Departamento struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DptoId     int32  `protobuf:"varint,1,opt,name=dpto_id,json=dptoId,proto3" json:"dpto_id,omitempty"`
+	DptoNombre string `protobuf:"bytes,2,opt,name=dpto_nombre,json=dptoNombre,proto3" json:"dpto_nombre,omitempty"`
+	Direccion  string `protobuf:"bytes,3,opt,name=direccion,proto3" json:"direccion,omitempty"`
+	Ciudad     string `protobuf:"bytes,4,opt,name=ciudad,proto3" json:"ciudad,omitempty"`
+}
+
+func (x *Departamento) Reset() {
+	*x = Departamento{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Departamento) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Departamento) ProtoMessage() {}
+
+func (x *Departamento) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Departamento.ProtoReflect.Descriptor instead.
+func (*Departamento) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Departamento) GetDptoId() int32 {
+	if x != nil {
+		return x.DptoId
+	}
+	return 0
+}
+
+func (x *Departamento) GetDptoNombre() string {
+	if x != nil {
+		return x.DptoNombre
+	}
+	return ""
+}
+
+func (x *Departamento) GetDireccion() string {
+	if x != nil {
+		return x.Direccion
+	}
+	return ""
+}
+
+func (x *Departamento) GetCiudad() string {
+	if x != nil {
+		return x.Ciudad
+	}
+	return ""
+}
+
+type DepartamentoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Departamentos []*Departamento `protobuf:"bytes,1,rep,name=departamentos,proto3" json:"departamentos,omitempty"`
+}
+
+func (x *DepartamentoList) Reset() {
+	*x = DepartamentoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepartamentoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartamentoList) ProtoMessage() {}
+
+func (x *DepartamentoList) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartamentoList.ProtoReflect.Descriptor instead.
+func (*DepartamentoList) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DepartamentoList) GetDepartamentos() []*Departamento {
+	if x != nil {
+		return x.Departamentos
+	}
+	return nil
+}
+
+type Gerente struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmplId         int32  `protobuf:"varint,1,opt,name=empl_id,json=emplId,proto3" json:"empl_id,omitempty"`
+	NombreCompleto string `protobuf:"bytes,2,opt,name=nombre_completo,json=nombreCompleto,proto3" json:"nombre_completo,omitempty"`
+}
+
+func (x *Gerente) Reset() {
+	*x = Gerente{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gerente) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gerente) ProtoMessage() {}
+
+func (x *Gerente) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gerente.ProtoReflect.Descriptor instead.
+func (*Gerente) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Gerente) GetEmplId() int32 {
+	if x != nil {
+		return x.EmplId
+	}
+	return 0
+}
+
+func (x *Gerente) GetNombreCompleto() string {
+	if x != nil {
+		return x.NombreCompleto
+	}
+	return ""
+}
+
+type CreateUsuarioRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usuario  string `protobuf:"bytes,1,opt,name=usuario,proto3" json:"usuario,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Rol      string `protobuf:"bytes,3,opt,name=rol,proto3" json:"rol,omitempty"`
+	EmplId   *int32 `protobuf:"varint,4,opt,name=empl_id,json=emplId,proto3,oneof" json:"empl_id,omitempty"`
+}
+
+func (x *CreateUsuarioRequest) Reset() {
+	*x = CreateUsuarioRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUsuarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUsuarioRequest) ProtoMessage() {}
+
+func (x *CreateUsuarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUsuarioRequest.ProtoReflect.Descriptor instead.
+func (*CreateUsuarioRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateUsuarioRequest) GetUsuario() string {
+	if x != nil {
+		return x.Usuario
+	}
+	return ""
+}
+
+func (x *CreateUsuarioRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUsuarioRequest) GetRol() string {
+	if x != nil {
+		return x.Rol
+	}
+	return ""
+}
+
+func (x *CreateUsuarioRequest) GetEmplId() int32 {
+	if x != nil && x.EmplId != nil {
+		return *x.EmplId
+	}
+	return 0
+}
+
+type UpdateUsuarioRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usuario             string  `protobuf:"bytes,1,opt,name=usuario,proto3" json:"usuario,omitempty"`
+	Password            *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Rol                 *string `protobuf:"bytes,3,opt,name=rol,proto3,oneof" json:"rol,omitempty"`
+	Activo              *bool   `protobuf:"varint,4,opt,name=activo,proto3,oneof" json:"activo,omitempty"`
+	EmplId              *int32  `protobuf:"varint,5,opt,name=empl_id,json=emplId,proto3,oneof" json:"empl_id,omitempty"`
+	DesvincularEmpleado bool    `protobuf:"varint,6,opt,name=desvincular_empleado,json=desvincularEmpleado,proto3" json:"desvincular_empleado,omitempty"`
+}
+
+func (x *UpdateUsuarioRequest) Reset() {
+	*x = UpdateUsuarioRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUsuarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUsuarioRequest) ProtoMessage() {}
+
+func (x *UpdateUsuarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUsuarioRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUsuarioRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateUsuarioRequest) GetUsuario() string {
+	if x != nil {
+		return x.Usuario
+	}
+	return ""
+}
+
+func (x *UpdateUsuarioRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *UpdateUsuarioRequest) GetRol() string {
+	if x != nil && x.Rol != nil {
+		return *x.Rol
+	}
+	return ""
+}
+
+func (x *UpdateUsuarioRequest) GetActivo() bool {
+	if x != nil && x.Activo != nil {
+		return *x.Activo
+	}
+	return false
+}
+
+func (x *UpdateUsuarioRequest) GetEmplId() int32 {
+	if x != nil && x.EmplId != nil {
+		return *x.EmplId
+	}
+	return 0
+}
+
+func (x *UpdateUsuarioRequest) GetDesvincularEmpleado() bool {
+	if x != nil {
+		return x.DesvincularEmpleado
+	}
+	return false
+}
+
+type Usuario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UsrId   int32  `protobuf:"varint,1,opt,name=usr_id,json=usrId,proto3" json:"usr_id,omitempty"`
+	Usuario string `protobuf:"bytes,2,opt,name=usuario,proto3" json:"usuario,omitempty"`
+	Rol     string `protobuf:"bytes,3,opt,name=rol,proto3" json:"rol,omitempty"`
+	EmplId  *int32 `protobuf:"varint,4,opt,name=empl_id,json=emplId,proto3,oneof" json:"empl_id,omitempty"`
+	Activo  bool   `protobuf:"varint,5,opt,name=activo,proto3" json:"activo,omitempty"`
+	Creado  string `protobuf:"bytes,6,opt,name=creado,proto3" json:"creado,omitempty"`
+}
+
+func (x *Usuario) Reset() {
+	*x = Usuario{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Usuario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usuario) ProtoMessage() {}
+
+func (x *Usuario) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usuario.ProtoReflect.Descriptor instead.
+func (*Usuario) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Usuario) GetUsrId() int32 {
+	if x != nil {
+		return x.UsrId
+	}
+	return 0
+}
+
+func (x *Usuario) GetUsuario() string {
+	if x != nil {
+		return x.Usuario
+	}
+	return ""
+}
+
+func (x *Usuario) GetRol() string {
+	if x != nil {
+		return x.Rol
+	}
+	return ""
+}
+
+func (x *Usuario) GetEmplId() int32 {
+	if x != nil && x.EmplId != nil {
+		return *x.EmplId
+	}
+	return 0
+}
+
+func (x *Usuario) GetActivo() bool {
+	if x != nil {
+		return x.Activo
+	}
+	return false
+}
+
+func (x *Usuario) GetCreado() string {
+	if x != nil {
+		return x.Creado
+	}
+	return ""
+}
+
+type UsuarioList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usuarios []*Usuario `protobuf:"bytes,1,rep,name=usuarios,proto3" json:"usuarios,omitempty"`
+}
+
+func (x *UsuarioList) Reset() {
+	*x = UsuarioList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsuarioList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsuarioList) ProtoMessage() {}
+
+func (x *UsuarioList) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsuarioList.ProtoReflect.Descriptor instead.
+func (*UsuarioList) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UsuarioList) GetUsuarios() []*Usuario {
+	if x != nil {
+		return x.Usuarios
+	}
+	return nil
+}
+
+type Rol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RolNombre      string   `protobuf:"bytes,1,opt,name=rol_nombre,json=rolNombre,proto3" json:"rol_nombre,omitempty"`
+	RolDescripcion string   `protobuf:"bytes,2,opt,name=rol_descripcion,json=rolDescripcion,proto3" json:"rol_descripcion,omitempty"`
+	RolAlcance     string   `protobuf:"bytes,3,opt,name=rol_alcance,json=rolAlcance,proto3" json:"rol_alcance,omitempty"`
+	Permisos       []string `protobuf:"bytes,4,rep,name=permisos,proto3" json:"permisos,omitempty"`
+}
+
+func (x *Rol) Reset() {
+	*x = Rol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rol) ProtoMessage() {}
+
+func (x *Rol) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rol.ProtoReflect.Descriptor instead.
+func (*Rol) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Rol) GetRolNombre() string {
+	if x != nil {
+		return x.RolNombre
+	}
+	return ""
+}
+
+func (x *Rol) GetRolDescripcion() string {
+	if x != nil {
+		return x.RolDescripcion
+	}
+	return ""
+}
+
+func (x *Rol) GetRolAlcance() string {
+	if x != nil {
+		return x.RolAlcance
+	}
+	return ""
+}
+
+func (x *Rol) GetPermisos() []string {
+	if x != nil {
+		return x.Permisos
+	}
+	return nil
+}
+
+type RolList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Roles []*Rol `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *RolList) Reset() {
+	*x = RolList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolList) ProtoMessage() {}
+
+func (x *RolList) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolList.ProtoReflect.Descriptor instead.
+func (*RolList) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RolList) GetRoles() []*Rol {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type DeleteRolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RolNombre string `protobuf:"bytes,1,opt,name=rol_nombre,json=rolNombre,proto3" json:"rol_nombre,omitempty"`
+}
+
+func (x *DeleteRolRequest) Reset() {
+	*x = DeleteRolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRolRequest) ProtoMessage() {}
+
+func (x *DeleteRolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRolRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRolRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteRolRequest) GetRolNombre() string {
+	if x != nil {
+		return x.RolNombre
+	}
+	return ""
+}
+
+type HealthStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vivo                  bool     `protobuf:"varint,1,opt,name=vivo,proto3" json:"vivo,omitempty"`
+	Listo                 bool     `protobuf:"varint,2,opt,name=listo,proto3" json:"listo,omitempty"`
+	Problemas             []string `protobuf:"bytes,3,rep,name=problemas,proto3" json:"problemas,omitempty"`
+	Uptime                string   `protobuf:"bytes,4,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	UptimeSegs            int64    `protobuf:"varint,5,opt,name=uptime_segs,json=uptimeSegs,proto3" json:"uptime_segs,omitempty"`
+	ConexionesAbiertas    int32    `protobuf:"varint,6,opt,name=conexiones_abiertas,json=conexionesAbiertas,proto3" json:"conexiones_abiertas,omitempty"`
+	Apagando              bool     `protobuf:"varint,7,opt,name=apagando,proto3" json:"apagando,omitempty"`
+	BaseDatos             string   `protobuf:"bytes,8,opt,name=base_datos,json=baseDatos,proto3" json:"base_datos,omitempty"`
+	BaseDatosOk           bool     `protobuf:"varint,9,opt,name=base_datos_ok,json=baseDatosOk,proto3" json:"base_datos_ok,omitempty"`
+	ConexionesBd          int32    `protobuf:"varint,10,opt,name=conexiones_bd,json=conexionesBd,proto3" json:"conexiones_bd,omitempty"`
+	VersionEsquema        int32    `protobuf:"varint,11,opt,name=version_esquema,json=versionEsquema,proto3" json:"version_esquema,omitempty"`
+	MigracionesPendientes int32    `protobuf:"varint,12,opt,name=migraciones_pendientes,json=migracionesPendientes,proto3" json:"migraciones_pendientes,omitempty"`
+}
+
+func (x *HealthStatus) Reset() {
+	*x = HealthStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthStatus) ProtoMessage() {}
+
+func (x *HealthStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthStatus.ProtoReflect.Descriptor instead.
+func (*HealthStatus) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HealthStatus) GetVivo() bool {
+	if x != nil {
+		return x.Vivo
+	}
+	return false
+}
+
+func (x *HealthStatus) GetListo() bool {
+	if x != nil {
+		return x.Listo
+	}
+	return false
+}
+
+func (x *HealthStatus) GetProblemas() []string {
+	if x != nil {
+		return x.Problemas
+	}
+	return nil
+}
+
+func (x *HealthStatus) GetUptime() string {
+	if x != nil {
+		return x.Uptime
+	}
+	return ""
+}
+
+func (x *HealthStatus) GetUptimeSegs() int64 {
+	if x != nil {
+		return x.UptimeSegs
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetConexionesAbiertas() int32 {
+	if x != nil {
+		return x.ConexionesAbiertas
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetApagando() bool {
+	if x != nil {
+		return x.Apagando
+	}
+	return false
+}
+
+func (x *HealthStatus) GetBaseDatos() string {
+	if x != nil {
+		return x.BaseDatos
+	}
+	return ""
+}
+
+func (x *HealthStatus) GetBaseDatosOk() bool {
+	if x != nil {
+		return x.BaseDatosOk
+	}
+	return false
+}
+
+func (x *HealthStatus) GetConexionesBd() int32 {
+	if x != nil {
+		return x.ConexionesBd
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetVersionEsquema() int32 {
+	if x != nil {
+		return x.VersionEsquema
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetMigracionesPendientes() int32 {
+	if x != nil {
+		return x.MigracionesPendientes
+	}
+	return 0
+}
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tipos   []string `protobuf:"bytes,1,rep,name=tipos,proto3" json:"tipos,omitempty"`
+	DptoIds []int32  `protobuf:"varint,2,rep,packed,name=dpto_ids,json=dptoIds,proto3" json:"dpto_ids,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubscribeRequest) GetTipos() []string {
+	if x != nil {
+		return x.Tipos
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetDptoIds() []int32 {
+	if x != nil {
+		return x.DptoIds
+	}
+	return nil
+}
+
+type Evento struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tipo           string `protobuf:"bytes,1,opt,name=tipo,proto3" json:"tipo,omitempty"`
+	Id             *int32 `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	DptoId         *int32 `protobuf:"varint,3,opt,name=dpto_id,json=dptoId,proto3,oneof" json:"dpto_id,omitempty"`
+	DptoAnteriorId *int32 `protobuf:"varint,4,opt,name=dpto_anterior_id,json=dptoAnteriorId,proto3,oneof" json:"dpto_anterior_id,omitempty"`
+	AuditId        *int64 `protobuf:"varint,5,opt,name=audit_id,json=auditId,proto3,oneof" json:"audit_id,omitempty"`
+	Operador       string `protobuf:"bytes,6,opt,name=operador,proto3" json:"operador,omitempty"`
+	Fecha          string `protobuf:"bytes,7,opt,name=fecha,proto3" json:"fecha,omitempty"`
+}
+
+func (x *Evento) Reset() {
+	*x = Evento{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Evento) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evento) ProtoMessage() {}
+
+func (x *Evento) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evento.ProtoReflect.Descriptor instead.
+func (*Evento) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Evento) GetTipo() string {
+	if x != nil {
+		return x.Tipo
+	}
+	return ""
+}
+
+func (x *Evento) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Evento) GetDptoId() int32 {
+	if x != nil && x.DptoId != nil {
+		return *x.DptoId
+	}
+	return 0
+}
+
+func (x *Evento) GetDptoAnteriorId() int32 {
+	if x != nil && x.DptoAnteriorId != nil {
+		return *x.DptoAnteriorId
+	}
+	return 0
+}
+
+func (x *Evento) GetAuditId() int64 {
+	if x != nil && x.AuditId != nil {
+		return *x.AuditId
+	}
+	return 0
+}
+
+func (x *Evento) GetOperador() string {
+	if x != nil {
+		return x.Operador
+	}
+	return ""
+}
+
+func (x *Evento) GetFecha() string {
+	if x != nil {
+		return x.Fecha
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url     string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Tipos   []string `protobuf:"bytes,2,rep,name=tipos,proto3" json:"tipos,omitempty"`
+	Secreto string   `protobuf:"bytes,3,opt,name=secreto,proto3" json:"secreto,omitempty"`
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetTipos() []string {
+	if x != nil {
+		return x.Tipos
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetSecreto() string {
+	if x != nil {
+		return x.Secreto
+	}
+	return ""
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WhId   int32    `protobuf:"varint,1,opt,name=wh_id,json=whId,proto3" json:"wh_id,omitempty"`
+	Url    string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Tipos  []string `protobuf:"bytes,3,rep,name=tipos,proto3" json:"tipos,omitempty"`
+	Activo bool     `protobuf:"varint,4,opt,name=activo,proto3" json:"activo,omitempty"`
+	Creado string   `protobuf:"bytes,5,opt,name=creado,proto3" json:"creado,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Webhook) GetWhId() int32 {
+	if x != nil {
+		return x.WhId
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetTipos() []string {
+	if x != nil {
+		return x.Tipos
+	}
+	return nil
+}
+
+func (x *Webhook) GetActivo() bool {
+	if x != nil {
+		return x.Activo
+	}
+	return false
+}
+
+func (x *Webhook) GetCreado() string {
+	if x != nil {
+		return x.Creado
+	}
+	return ""
+}
+
+type WebhookList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *WebhookList) Reset() {
+	*x = WebhookList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookList) ProtoMessage() {}
+
+func (x *WebhookList) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookList.ProtoReflect.Descriptor instead.
+func (*WebhookList) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WebhookList) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type UpdateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WhId   int32 `protobuf:"varint,1,opt,name=wh_id,json=whId,proto3" json:"wh_id,omitempty"`
+	Activo *bool `protobuf:"varint,2,opt,name=activo,proto3,oneof" json:"activo,omitempty"`
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateWebhookRequest) GetWhId() int32 {
+	if x != nil {
+		return x.WhId
+	}
+	return 0
+}
+
+func (x *UpdateWebhookRequest) GetActivo() bool {
+	if x != nil && x.Activo != nil {
+		return *x.Activo
+	}
+	return false
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WhId int32 `protobuf:"varint,1,opt,name=wh_id,json=whId,proto3" json:"wh_id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteWebhookRequest) GetWhId() int32 {
+	if x != nil {
+		return x.WhId
+	}
+	return 0
+}
+
+type EntregaWebhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntregaId   int64            `protobuf:"varint,1,opt,name=entrega_id,json=entregaId,proto3" json:"entrega_id,omitempty"`
+	WhId        int32            `protobuf:"varint,2,opt,name=wh_id,json=whId,proto3" json:"wh_id,omitempty"`
+	Url         string           `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Tipo        string           `protobuf:"bytes,4,opt,name=tipo,proto3" json:"tipo,omitempty"`
+	Intentos    int32            `protobuf:"varint,5,opt,name=intentos,proto3" json:"intentos,omitempty"`
+	UltimoError string           `protobuf:"bytes,6,opt,name=ultimo_error,json=ultimoError,proto3" json:"ultimo_error,omitempty"`
+	Creado      string           `protobuf:"bytes,7,opt,name=creado,proto3" json:"creado,omitempty"`
+	Payload     *structpb.Struct `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *EntregaWebhook) Reset() {
+	*x = EntregaWebhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntregaWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntregaWebhook) ProtoMessage() {}
+
+func (x *EntregaWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntregaWebhook.ProtoReflect.Descriptor instead.
+func (*EntregaWebhook) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EntregaWebhook) GetEntregaId() int64 {
+	if x != nil {
+		return x.EntregaId
+	}
+	return 0
+}
+
+func (x *EntregaWebhook) GetWhId() int32 {
+	if x != nil {
+		return x.WhId
+	}
+	return 0
+}
+
+func (x *EntregaWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EntregaWebhook) GetTipo() string {
+	if x != nil {
+		return x.Tipo
+	}
+	return ""
+}
+
+func (x *EntregaWebhook) GetIntentos() int32 {
+	if x != nil {
+		return x.Intentos
+	}
+	return 0
+}
+
+func (x *EntregaWebhook) GetUltimoError() string {
+	if x != nil {
+		return x.UltimoError
+	}
+	return ""
+}
+
+func (x *EntregaWebhook) GetCreado() string {
+	if x != nil {
+		return x.Creado
+	}
+	return ""
+}
+
+func (x *EntregaWebhook) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type EntregaWebhookList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entregas []*EntregaWebhook `protobuf:"bytes,1,rep,name=entregas,proto3" json:"entregas,omitempty"`
+}
+
+func (x *EntregaWebhookList) Reset() {
+	*x = EntregaWebhookList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntregaWebhookList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntregaWebhookList) ProtoMessage() {}
+
+func (x *EntregaWebhookList) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntregaWebhookList.ProtoReflect.Descriptor instead.
+func (*EntregaWebhookList) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EntregaWebhookList) GetEntregas() []*EntregaWebhook {
+	if x != nil {
+		return x.Entregas
+	}
+	return nil
+}
+
+type RetryEntregaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntregaId int64 `protobuf:"varint,1,opt,name=entrega_id,json=entregaId,proto3" json:"entrega_id,omitempty"`
+}
+
+func (x *RetryEntregaRequest) Reset() {
+	*x = RetryEntregaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hr_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryEntregaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryEntregaRequest) ProtoMessage() {}
+
+func (x *RetryEntregaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryEntregaRequest.ProtoReflect.Descriptor instead.
+func (*RetryEntregaRequest) Descriptor() ([]byte, []int) {
+	return file_hr_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RetryEntregaRequest) GetEntregaId() int64 {
+	if x != nil {
+		return x.EntregaId
+	}
+	return 0
+}
+
+var File_hr_proto protoreflect.FileDescriptor
+
+var file_hr_proto_rawDesc = []byte{
+	0x0a, 0x08, 0x68, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x68, 0x72, 0x2e, 0x76,
+	0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x6f, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x6c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x65,
+	0x6d, 0x70, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x64, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x64, 0x61, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x9d, 0x03, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x12,
+	0x2c, 0x0a, 0x12, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x6e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6d, 0x70,
+	0x6c, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x33, 0x0a,
+	0x13, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f,
+	0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x65, 0x6d,
+	0x70, 0x6c, 0x53, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x70, 0x6c, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x66, 0x65, 0x63, 0x68, 0x61, 0x5f,
+	0x6e, 0x61, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x46,
+	0x65, 0x63, 0x68, 0x61, 0x4e, 0x61, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x5f,
+	0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x6d,
+	0x70, 0x6c, 0x53, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d, 0x70, 0x6c,
+	0x5f, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x43, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
+	0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0d, 0x65, 0x6d,
+	0x70, 0x6c, 0x47, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x64, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x44, 0x70, 0x74, 0x6f, 0x49, 0x64,
+	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x6d, 0x70,
+	0x6c, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xd9, 0x03, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6d, 0x70, 0x6c,
+	0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x72,
+	0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73,
+	0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x65, 0x6d, 0x70, 0x6c, 0x53, 0x65, 0x67, 0x75, 0x6e,
+	0x64, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x6d, 0x70, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x66, 0x65, 0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x46, 0x65, 0x63, 0x68, 0x61, 0x4e, 0x61, 0x63,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x53, 0x75, 0x65, 0x6c, 0x64,
+	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x43, 0x6f,
+	0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63,
+	0x61, 0x72, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65,
+	0x6d, 0x70, 0x6c, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0f, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x47, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x5f,
+	0x64, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65,
+	0x6d, 0x70, 0x6c, 0x44, 0x70, 0x74, 0x6f, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0x0a, 0x14,
+	0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f,
+	0x6d, 0x62, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x67, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xd8, 0x05, 0x0a, 0x0d, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x6d, 0x70,
+	0x6c, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x12, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x6d,
+	0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x10, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x4e, 0x6f, 0x6d,
+	0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73,
+	0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x11, 0x65, 0x6d, 0x70, 0x6c, 0x53, 0x65, 0x67, 0x75, 0x6e,
+	0x64, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x16, 0x6c,
+	0x69, 0x6d, 0x70, 0x69, 0x61, 0x72, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6c, 0x69, 0x6d,
+	0x70, 0x69, 0x61, 0x72, 0x53, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72,
+	0x65, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x65, 0x6d, 0x70, 0x6c, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x66, 0x65,
+	0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x46, 0x65, 0x63, 0x68, 0x61, 0x4e, 0x61, 0x63, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x53, 0x75, 0x65,
+	0x6c, 0x64, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63,
+	0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52,
+	0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x43, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x27, 0x0a, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x43,
+	0x61, 0x72, 0x67, 0x6f, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x65, 0x6d, 0x70,
+	0x6c, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x07, 0x52, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x47, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6d, 0x70, 0x69, 0x61,
+	0x72, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6c, 0x69, 0x6d, 0x70, 0x69, 0x61, 0x72, 0x47, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x12,
+	0x25, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x64, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x48, 0x08, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x44, 0x70, 0x74,
+	0x6f, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x6d,
+	0x70, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65,
+	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6d, 0x70,
+	0x6c, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6d, 0x70, 0x6c,
+	0x5f, 0x66, 0x65, 0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x64, 0x70, 0x74, 0x6f,
+	0x5f, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6d, 0x70,
+	0x6c, 0x65, 0x61, 0x64, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x22, 0x4c,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f,
+	0x12, 0x17, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x65, 0x6d, 0x70, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x05, 0x0a, 0x0e,
+	0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x4e, 0x6f, 0x6d,
+	0x62, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x73,
+	0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x66, 0x65,
+	0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x08, 0x66, 0x65, 0x63, 0x68, 0x61, 0x4e, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x06,
+	0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x08, 0x63,
+	0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x6e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72,
+	0x67, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x67, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x09,
+	0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e,
+	0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0d, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x4e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x70, 0x74, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x70, 0x74, 0x6f, 0x49,
+	0x64, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x4e, 0x6f, 0x6d, 0x62,
+	0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x63, 0x69, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x63, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x75, 0x64, 0x61, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x69, 0x75, 0x64, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f,
+	0x6d, 0x62, 0x72, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x66, 0x65, 0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6f, 0x6d, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f,
+	0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x22, 0xc8, 0x04, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x72, 0x69, 0x6d,
+	0x65, 0x72, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x6d, 0x70, 0x6c,
+	0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x65, 0x6d, 0x70, 0x6c, 0x53, 0x65, 0x67,
+	0x75, 0x6e, 0x64, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
+	0x0e, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x66, 0x65, 0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x46, 0x65, 0x63,
+	0x68, 0x61, 0x4e, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x72, 0x67,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x61, 0x72, 0x67, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x0e,
+	0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x4e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c,
+	0x5f, 0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52,
+	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x53, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x43, 0x6f, 0x6d,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x63, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x63, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x75, 0x64, 0x61, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x69, 0x75, 0x64, 0x61, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e,
+	0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x66, 0x65, 0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xc8, 0x04, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c,
+	0x65, 0x61, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65,
+	0x6d, 0x70, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x70, 0x72,
+	0x69, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x65, 0x6d, 0x70, 0x6c, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x72, 0x4e, 0x6f, 0x6d,
+	0x62, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x13, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x65, 0x67, 0x75,
+	0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x11, 0x65, 0x6d, 0x70, 0x6c, 0x53, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x4e,
+	0x6f, 0x6d, 0x62, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x65, 0x6d, 0x70, 0x6c,
+	0x5f, 0x66, 0x65, 0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x46, 0x65, 0x63, 0x68, 0x61, 0x4e, 0x61, 0x63,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d,
+	0x62, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f,
+	0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x67, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0d, 0x67, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x75, 0x65, 0x6c,
+	0x64, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
+	0x53, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x6d, 0x70,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x04, 0x52, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x43, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x63, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x63, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x75, 0x64, 0x61, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x69, 0x75, 0x64, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0x0a, 0x14,
+	0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x65, 0x67, 0x75, 0x6e, 0x64, 0x6f, 0x5f, 0x6e, 0x6f,
+	0x6d, 0x62, 0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x66, 0x65,
+	0x63, 0x68, 0x61, 0x5f, 0x6e, 0x61, 0x63, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x67, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x73, 0x75, 0x65, 0x6c, 0x64, 0x6f, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x09,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x65, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x36, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xcb,
+	0x01, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5b, 0x0a, 0x0d,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x73, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x73, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x61, 0x73, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
+	0x61, 0x73, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x9d, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x75, 0x64, 0x69, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x65, 0x63, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x65, 0x63, 0x68, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x64,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x64,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x63, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x63, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x07, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x65,
+	0x6d, 0x70, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x05, 0x61, 0x6e, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x05, 0x61, 0x6e, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x73, 0x70, 0x75,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x07, 0x64, 0x65, 0x73, 0x70, 0x75, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x05, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x22, 0x31, 0x0a,
+	0x09, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x68, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x06, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x73,
+	0x22, 0x7e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x64, 0x70, 0x74, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x70, 0x74,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x70, 0x74, 0x6f, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x63, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x63, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x69, 0x75, 0x64,
+	0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x69, 0x75, 0x64, 0x61, 0x64,
+	0x22, 0x4d, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f,
+	0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x73, 0x22,
+	0x4b, 0x0a, 0x07, 0x47, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x65, 0x6d, 0x70,
+	0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x6f,
+	0x6d, 0x62, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x6f, 0x22, 0x88, 0x01, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x6f, 0x6c, 0x12, 0x1c, 0x0a,
+	0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x72,
+	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x72, 0x6f, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x02, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x6f, 0x88, 0x01, 0x01, 0x12,
+	0x1c, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x03, 0x52, 0x06, 0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x14, 0x64, 0x65, 0x73, 0x76, 0x69, 0x6e, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x65, 0x6d, 0x70,
+	0x6c, 0x65, 0x61, 0x64, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x65, 0x73,
+	0x76, 0x69, 0x6e, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x72, 0x6f, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x6f,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xa6, 0x01, 0x0a,
+	0x07, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x73, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x75, 0x73, 0x72, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x07, 0x65,
+	0x6d, 0x70, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06,
+	0x65, 0x6d, 0x70, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6d,
+	0x70, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x0b, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x73,
+	0x22, 0x8a, 0x01, 0x0a, 0x03, 0x52, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x5f,
+	0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f,
+	0x6c, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x6c, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x63, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x63, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x5f, 0x61, 0x6c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x41, 0x6c, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x6f, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x6f, 0x73, 0x22, 0x2b, 0x0a,
+	0x07, 0x52, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6c, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x6f, 0x6c, 0x5f, 0x6e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x4e, 0x6f, 0x6d, 0x62, 0x72, 0x65, 0x22, 0xa4, 0x03,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x69, 0x76, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x76, 0x69,
+	0x76, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x67, 0x73, 0x12,
+	0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x65, 0x78, 0x69, 0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x61, 0x62,
+	0x69, 0x65, 0x72, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f,
+	0x6e, 0x65, 0x78, 0x69, 0x6f, 0x6e, 0x65, 0x73, 0x41, 0x62, 0x69, 0x65, 0x72, 0x74, 0x61, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x61, 0x67, 0x61, 0x6e, 0x64, 0x6f, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x61, 0x67, 0x61, 0x6e, 0x64, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x6f, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x6f, 0x6b, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x6f, 0x73, 0x4f, 0x6b, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x65, 0x78, 0x69, 0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x62, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x65, 0x78, 0x69, 0x6f, 0x6e,
+	0x65, 0x73, 0x42, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x73, 0x71, 0x75, 0x65, 0x6d, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x73, 0x71, 0x75, 0x65, 0x6d, 0x61, 0x12, 0x35, 0x0a,
+	0x16, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x63, 0x69, 0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6d,
+	0x69, 0x67, 0x72, 0x61, 0x63, 0x69, 0x6f, 0x6e, 0x65, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x70, 0x6f,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x70, 0x6f, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x07, 0x64, 0x70, 0x74, 0x6f, 0x49, 0x64, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x06, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a,
+	0x07, 0x64, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x06, 0x64, 0x70, 0x74, 0x6f, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x64,
+	0x70, 0x74, 0x6f, 0x5f, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0e, 0x64, 0x70, 0x74, 0x6f, 0x41, 0x6e, 0x74,
+	0x65, 0x72, 0x69, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x07,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x65, 0x63, 0x68, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x65, 0x63, 0x68, 0x61, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x70, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x64, 0x70, 0x74, 0x6f, 0x5f, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0x58, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x70, 0x6f,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x07, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x13, 0x0a, 0x05, 0x77, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x68, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x70, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x72, 0x65, 0x61, 0x64, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x65,
+	0x61, 0x64, 0x6f, 0x22, 0x39, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x53,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x77, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x68, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x6f, 0x22, 0x2b, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x77,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x68, 0x49, 0x64,
+	0x22, 0xf4, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61,
+	0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x77, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x77, 0x68, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x6f, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6c, 0x74,
+	0x69, 0x6d, 0x6f, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x6c, 0x74, 0x69, 0x6d, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72,
+	0x65, 0x61, 0x64, 0x6f, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x47, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x72, 0x65,
+	0x67, 0x61, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x08, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x73,
+	0x22, 0x34, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x65,
+	0x67, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x74,
+	0x72, 0x65, 0x67, 0x61, 0x49, 0x64, 0x32, 0x9d, 0x0d, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x73, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a,
+	0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x13, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x15,
+	0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70,
+	0x6c, 0x65, 0x61, 0x64, 0x6f, 0x1a, 0x1d, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15,
+	0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70,
+	0x6c, 0x65, 0x61, 0x64, 0x6f, 0x1a, 0x1d, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x2e,
+	0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x70, 0x6c, 0x65,
+	0x61, 0x64, 0x6f, 0x1a, 0x1d, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x15, 0x2e, 0x68,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x65,
+	0x61, 0x64, 0x6f, 0x1a, 0x15, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x6c,
+	0x65, 0x61, 0x64, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x16,
+	0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6d,
+	0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x1a, 0x1d, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x6c, 0x65, 0x61, 0x64, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x13,
+	0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x68,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x65, 0x30, 0x01, 0x12, 0x36,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72,
+	0x67, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x73, 0x43, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x6f, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x68, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x75, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x1b, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x75, 0x61, 0x72,
+	0x69, 0x6f, 0x12, 0x3c, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x75, 0x61,
+	0x72, 0x69, 0x6f, 0x12, 0x1b, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f,
+	0x12, 0x3a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x75, 0x61, 0x72, 0x69, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x0e, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x12, 0x0a, 0x2e, 0x68,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x1a, 0x0a, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x12, 0x17, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x17, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x68, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x3a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x12, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x69, 0x64, 0x61, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x65,
+	0x67, 0x61, 0x12, 0x1a, 0x2e, 0x68, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x65, 0x67, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x17, 0x5a, 0x15, 0x68, 0x72, 0x2d, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x68, 0x72, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hr_proto_rawDescOnce sync.Once
+	file_hr_proto_rawDescData = file_hr_proto_rawDesc
+)
+
+func file_hr_proto_rawDescGZIP() []byte {
+	file_hr_proto_rawDescOnce.Do(func() {
+		file_hr_proto_rawDescData = protoimpl.X.CompressGZIP(file_hr_proto_rawDescData)
+	})
+	return file_hr_proto_rawDescData
+}
+
+var file_hr_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_hr_proto_goTypes = []any{
+	(*LoginRequest)(nil),           // 0: hr.v1.LoginRequest
+	(*LoginResponse)(nil),          // 1: hr.v1.LoginResponse
+	(*CreateEmpleado)(nil),         // 2: hr.v1.CreateEmpleado
+	(*UpdateEmpleado)(nil),         // 3: hr.v1.UpdateEmpleado
+	(*PatchEmpleado)(nil),          // 4: hr.v1.PatchEmpleado
+	(*SelectEmpleado)(nil),         // 5: hr.v1.SelectEmpleado
+	(*DeleteEmpleado)(nil),         // 6: hr.v1.DeleteEmpleado
+	(*RestoreEmpleado)(nil),        // 7: hr.v1.RestoreEmpleado
+	(*EmpleadoDetail)(nil),         // 8: hr.v1.EmpleadoDetail
+	(*CreateEmpleadoResponse)(nil), // 9: hr.v1.CreateEmpleadoResponse
+	(*UpdateEmpleadoResponse)(nil), // 10: hr.v1.UpdateEmpleadoResponse
+	(*BatchStep)(nil),              // 11: hr.v1.BatchStep
+	(*BatchRequest)(nil),           // 12: hr.v1.BatchRequest
+	(*BatchStepResult)(nil),        // 13: hr.v1.BatchStepResult
+	(*BatchResponse)(nil),          // 14: hr.v1.BatchResponse
+	(*ListAuditRequest)(nil),       // 15: hr.v1.ListAuditRequest
+	(*AuditEntry)(nil),             // 16: hr.v1.AuditEntry
+	(*Cargo)(nil),                  // 17: hr.v1.Cargo
+	(*CargoList)(nil),              // 18: hr.v1.CargoList
+	(*Departamento)(nil),           // 19: hr.v1.Departamento
+	(*DepartamentoList)(nil),       // 20: hr.v1.DepartamentoList
+	(*Gerente)(nil),                // 21: hr.v1.Gerente
+	(*CreateUsuarioRequest)(nil),   // 22: hr.v1.CreateUsuarioRequest
+	(*UpdateUsuarioRequest)(nil),   // 23: hr.v1.UpdateUsuarioRequest
+	(*Usuario)(nil),                // 24: hr.v1.Usuario
+	(*UsuarioList)(nil),            // 25: hr.v1.UsuarioList
+	(*Rol)(nil),                    // 26: hr.v1.Rol
+	(*RolList)(nil),                // 27: hr.v1.RolList
+	(*DeleteRolRequest)(nil),       // 28: hr.v1.DeleteRolRequest
+	(*HealthStatus)(nil),           // 29: hr.v1.HealthStatus
+	(*SubscribeRequest)(nil),       // 30: hr.v1.SubscribeRequest
+	(*Evento)(nil),                 // 31: hr.v1.Evento
+	(*CreateWebhookRequest)(nil),   // 32: hr.v1.CreateWebhookRequest
+	(*Webhook)(nil),                // 33: hr.v1.Webhook
+	(*WebhookList)(nil),            // 34: hr.v1.WebhookList
+	(*UpdateWebhookRequest)(nil),   // 35: hr.v1.UpdateWebhookRequest
+	(*DeleteWebhookRequest)(nil),   // 36: hr.v1.DeleteWebhookRequest
+	(*EntregaWebhook)(nil),         // 37: hr.v1.EntregaWebhook
+	(*EntregaWebhookList)(nil),     // 38: hr.v1.EntregaWebhookList
+	(*RetryEntregaRequest)(nil),    // 39: hr.v1.RetryEntregaRequest
+	(*structpb.Struct)(nil),        // 40: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 41: google.protobuf.Value
+	(*emptypb.Empty)(nil),          // 42: google.protobuf.Empty
+}
+var file_hr_proto_depIdxs = []int32{
+	40, // 0: hr.v1.BatchStep.data:type_name -> google.protobuf.Struct
+	11, // 1: hr.v1.BatchRequest.steps:type_name -> hr.v1.BatchStep
+	41, // 2: hr.v1.BatchStepResult.data:type_name -> google.protobuf.Value
+	13, // 3: hr.v1.BatchResponse.steps:type_name -> hr.v1.BatchStepResult
+	40, // 4: hr.v1.AuditEntry.antes:type_name -> google.protobuf.Struct
+	40, // 5: hr.v1.AuditEntry.despues:type_name -> google.protobuf.Struct
+	17, // 6: hr.v1.CargoList.cargos:type_name -> hr.v1.Cargo
+	19, // 7: hr.v1.DepartamentoList.departamentos:type_name -> hr.v1.Departamento
+	24, // 8: hr.v1.UsuarioList.usuarios:type_name -> hr.v1.Usuario
+	26, // 9: hr.v1.RolList.roles:type_name -> hr.v1.Rol
+	33, // 10: hr.v1.WebhookList.webhooks:type_name -> hr.v1.Webhook
+	40, // 11: hr.v1.EntregaWebhook.payload:type_name -> google.protobuf.Struct
+	37, // 12: hr.v1.EntregaWebhookList.entregas:type_name -> hr.v1.EntregaWebhook
+	0,  // 13: hr.v1.RecursosHumanos.Login:input_type -> hr.v1.LoginRequest
+	42, // 14: hr.v1.RecursosHumanos.Logout:input_type -> google.protobuf.Empty
+	42, // 15: hr.v1.RecursosHumanos.Ping:input_type -> google.protobuf.Empty
+	42, // 16: hr.v1.RecursosHumanos.Health:input_type -> google.protobuf.Empty
+	2,  // 17: hr.v1.RecursosHumanos.Insert:input_type -> hr.v1.CreateEmpleado
+	3,  // 18: hr.v1.RecursosHumanos.Update:input_type -> hr.v1.UpdateEmpleado
+	4,  // 19: hr.v1.RecursosHumanos.Patch:input_type -> hr.v1.PatchEmpleado
+	5,  // 20: hr.v1.RecursosHumanos.Select:input_type -> hr.v1.SelectEmpleado
+	6,  // 21: hr.v1.RecursosHumanos.Delete:input_type -> hr.v1.DeleteEmpleado
+	7,  // 22: hr.v1.RecursosHumanos.Restore:input_type -> hr.v1.RestoreEmpleado
+	12, // 23: hr.v1.RecursosHumanos.Batch:input_type -> hr.v1.BatchRequest
+	15, // 24: hr.v1.RecursosHumanos.ListAudit:input_type -> hr.v1.ListAuditRequest
+	42, // 25: hr.v1.RecursosHumanos.ListGerentes:input_type -> google.protobuf.Empty
+	42, // 26: hr.v1.RecursosHumanos.ListCargos:input_type -> google.protobuf.Empty
+	42, // 27: hr.v1.RecursosHumanos.ListDepartamentosConDatos:input_type -> google.protobuf.Empty
+	22, // 28: hr.v1.RecursosHumanos.CreateUsuario:input_type -> hr.v1.CreateUsuarioRequest
+	23, // 29: hr.v1.RecursosHumanos.UpdateUsuario:input_type -> hr.v1.UpdateUsuarioRequest
+	42, // 30: hr.v1.RecursosHumanos.ListUsuarios:input_type -> google.protobuf.Empty
+	42, // 31: hr.v1.RecursosHumanos.ListRoles:input_type -> google.protobuf.Empty
+	26, // 32: hr.v1.RecursosHumanos.SaveRol:input_type -> hr.v1.Rol
+	28, // 33: hr.v1.RecursosHumanos.DeleteRol:input_type -> hr.v1.DeleteRolRequest
+	30, // 34: hr.v1.RecursosHumanos.Subscribe:input_type -> hr.v1.SubscribeRequest
+	32, // 35: hr.v1.RecursosHumanos.CreateWebhook:input_type -> hr.v1.CreateWebhookRequest
+	42, // 36: hr.v1.RecursosHumanos.ListWebhooks:input_type -> google.protobuf.Empty
+	35, // 37: hr.v1.RecursosHumanos.UpdateWebhook:input_type -> hr.v1.UpdateWebhookRequest
+	36, // 38: hr.v1.RecursosHumanos.DeleteWebhook:input_type -> hr.v1.DeleteWebhookRequest
+	42, // 39: hr.v1.RecursosHumanos.ListEntregasFallidas:input_type -> google.protobuf.Empty
+	39, // 40: hr.v1.RecursosHumanos.RetryEntrega:input_type -> hr.v1.RetryEntregaRequest
+	1,  // 41: hr.v1.RecursosHumanos.Login:output_type -> hr.v1.LoginResponse
+	42, // 42: hr.v1.RecursosHumanos.Logout:output_type -> google.protobuf.Empty
+	29, // 43: hr.v1.RecursosHumanos.Ping:output_type -> hr.v1.HealthStatus
+	29, // 44: hr.v1.RecursosHumanos.Health:output_type -> hr.v1.HealthStatus
+	9,  // 45: hr.v1.RecursosHumanos.Insert:output_type -> hr.v1.CreateEmpleadoResponse
+	10, // 46: hr.v1.RecursosHumanos.Update:output_type -> hr.v1.UpdateEmpleadoResponse
+	10, // 47: hr.v1.RecursosHumanos.Patch:output_type -> hr.v1.UpdateEmpleadoResponse
+	8,  // 48: hr.v1.RecursosHumanos.Select:output_type -> hr.v1.EmpleadoDetail
+	42, // 49: hr.v1.RecursosHumanos.Delete:output_type -> google.protobuf.Empty
+	10, // 50: hr.v1.RecursosHumanos.Restore:output_type -> hr.v1.UpdateEmpleadoResponse
+	14, // 51: hr.v1.RecursosHumanos.Batch:output_type -> hr.v1.BatchResponse
+	16, // 52: hr.v1.RecursosHumanos.ListAudit:output_type -> hr.v1.AuditEntry
+	21, // 53: hr.v1.RecursosHumanos.ListGerentes:output_type -> hr.v1.Gerente
+	18, // 54: hr.v1.RecursosHumanos.ListCargos:output_type -> hr.v1.CargoList
+	20, // 55: hr.v1.RecursosHumanos.ListDepartamentosConDatos:output_type -> hr.v1.DepartamentoList
+	24, // 56: hr.v1.RecursosHumanos.CreateUsuario:output_type -> hr.v1.Usuario
+	24, // 57: hr.v1.RecursosHumanos.UpdateUsuario:output_type -> hr.v1.Usuario
+	25, // 58: hr.v1.RecursosHumanos.ListUsuarios:output_type -> hr.v1.UsuarioList
+	27, // 59: hr.v1.RecursosHumanos.ListRoles:output_type -> hr.v1.RolList
+	26, // 60: hr.v1.RecursosHumanos.SaveRol:output_type -> hr.v1.Rol
+	42, // 61: hr.v1.RecursosHumanos.DeleteRol:output_type -> google.protobuf.Empty
+	31, // 62: hr.v1.RecursosHumanos.Subscribe:output_type -> hr.v1.Evento
+	33, // 63: hr.v1.RecursosHumanos.CreateWebhook:output_type -> hr.v1.Webhook
+	34, // 64: hr.v1.RecursosHumanos.ListWebhooks:output_type -> hr.v1.WebhookList
+	33, // 65: hr.v1.RecursosHumanos.UpdateWebhook:output_type -> hr.v1.Webhook
+	42, // 66: hr.v1.RecursosHumanos.DeleteWebhook:output_type -> google.protobuf.Empty
+	38, // 67: hr.v1.RecursosHumanos.ListEntregasFallidas:output_type -> hr.v1.EntregaWebhookList
+	42, // 68: hr.v1.RecursosHumanos.RetryEntrega:output_type -> google.protobuf.Empty
+	41, // [41:69] is the sub-list for method output_type
+	13, // [13:41] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_hr_proto_init() }
+func file_hr_proto_init() {
+	if File_hr_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hr_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateEmpleado); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateEmpleado); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*PatchEmpleado); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SelectEmpleado); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteEmpleado); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreEmpleado); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*EmpleadoDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateEmpleadoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateEmpleadoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchStepResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Cargo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CargoList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*Departamento); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*DepartamentoList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*Gerente); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateUsuarioRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUsuarioRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*Usuario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*UsuarioList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*Rol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RolList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*Evento); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*WebhookList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*EntregaWebhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*EntregaWebhookList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hr_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*RetryEntregaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_hr_proto_msgTypes[1].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[2].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[3].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[4].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[8].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[9].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[10].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[15].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[16].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[22].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[23].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[24].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[31].OneofWrappers = []any{}
+	file_hr_proto_msgTypes[35].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hr_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   40,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hr_proto_goTypes,
+		DependencyIndexes: file_hr_proto_depIdxs,
+		MessageInfos:      file_hr_proto_msgTypes,
+	}.Build()
+	File_hr_proto = out.File
+	file_hr_proto_rawDesc = nil
+	file_hr_proto_goTypes = nil
+	file_hr_proto_depIdxs = nil
+}
@@ -0,0 +1,1182 @@
+// Servicio gRPC equivalente al protocolo de socket. Cada RPC ejecuta la
+// operación del mismo nombre de processRequest, con la misma autenticación,
+// permisos, alcance y enmascarado. Los nombres de campo coinciden con las
+// claves JSON de los DTOs de shared. UNSUBSCRIBE no tiene RPC: la
+// suscripción termina al cancelar el stream de Subscribe.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: hr.proto
+
+package hrpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RecursosHumanos_Login_FullMethodName                     = "/hr.v1.RecursosHumanos/Login"
+	RecursosHumanos_Logout_FullMethodName                    = "/hr.v1.RecursosHumanos/Logout"
+	RecursosHumanos_Ping_FullMethodName                      = "/hr.v1.RecursosHumanos/Ping"
+	RecursosHumanos_Health_FullMethodName                    = "/hr.v1.RecursosHumanos/Health"
+	RecursosHumanos_Insert_FullMethodName                    = "/hr.v1.RecursosHumanos/Insert"
+	RecursosHumanos_Update_FullMethodName                    = "/hr.v1.RecursosHumanos/Update"
+	RecursosHumanos_Patch_FullMethodName                     = "/hr.v1.RecursosHumanos/Patch"
+	RecursosHumanos_Select_FullMethodName                    = "/hr.v1.RecursosHumanos/Select"
+	RecursosHumanos_Delete_FullMethodName                    = "/hr.v1.RecursosHumanos/Delete"
+	RecursosHumanos_Restore_FullMethodName                   = "/hr.v1.RecursosHumanos/Restore"
+	RecursosHumanos_Batch_FullMethodName                     = "/hr.v1.RecursosHumanos/Batch"
+	RecursosHumanos_ListAudit_FullMethodName                 = "/hr.v1.RecursosHumanos/ListAudit"
+	RecursosHumanos_ListGerentes_FullMethodName              = "/hr.v1.RecursosHumanos/ListGerentes"
+	RecursosHumanos_ListCargos_FullMethodName                = "/hr.v1.RecursosHumanos/ListCargos"
+	RecursosHumanos_ListDepartamentosConDatos_FullMethodName = "/hr.v1.RecursosHumanos/ListDepartamentosConDatos"
+	RecursosHumanos_CreateUsuario_FullMethodName             = "/hr.v1.RecursosHumanos/CreateUsuario"
+	RecursosHumanos_UpdateUsuario_FullMethodName             = "/hr.v1.RecursosHumanos/UpdateUsuario"
+	RecursosHumanos_ListUsuarios_FullMethodName              = "/hr.v1.RecursosHumanos/ListUsuarios"
+	RecursosHumanos_ListRoles_FullMethodName                 = "/hr.v1.RecursosHumanos/ListRoles"
+	RecursosHumanos_SaveRol_FullMethodName                   = "/hr.v1.RecursosHumanos/SaveRol"
+	RecursosHumanos_DeleteRol_FullMethodName                 = "/hr.v1.RecursosHumanos/DeleteRol"
+	RecursosHumanos_Subscribe_FullMethodName                 = "/hr.v1.RecursosHumanos/Subscribe"
+	RecursosHumanos_CreateWebhook_FullMethodName             = "/hr.v1.RecursosHumanos/CreateWebhook"
+	RecursosHumanos_ListWebhooks_FullMethodName              = "/hr.v1.RecursosHumanos/ListWebhooks"
+	RecursosHumanos_UpdateWebhook_FullMethodName             = "/hr.v1.RecursosHumanos/UpdateWebhook"
+	RecursosHumanos_DeleteWebhook_FullMethodName             = "/hr.v1.RecursosHumanos/DeleteWebhook"
+	RecursosHumanos_ListEntregasFallidas_FullMethodName      = "/hr.v1.RecursosHumanos/ListEntregasFallidas"
+	RecursosHumanos_RetryEntrega_FullMethodName              = "/hr.v1.RecursosHumanos/RetryEntrega"
+)
+
+// RecursosHumanosClient is the client API for RecursosHumanos service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecursosHumanosClient interface {
+	// Login devuelve un token que se envía en el metadato
+	// "authorization: Bearer <token>" del resto de llamadas. Con mTLS se
+	// puede omitir la contraseña.
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Ping y Health no requieren token. Ping informa solo si el servidor
+	// atiende y, con token, renueva la sesión, como PING durante una
+	// suscripción. Health falla con UNAVAILABLE si el servidor no está listo.
+	Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatus, error)
+	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatus, error)
+	Insert(ctx context.Context, in *CreateEmpleado, opts ...grpc.CallOption) (*CreateEmpleadoResponse, error)
+	Update(ctx context.Context, in *UpdateEmpleado, opts ...grpc.CallOption) (*UpdateEmpleadoResponse, error)
+	Patch(ctx context.Context, in *PatchEmpleado, opts ...grpc.CallOption) (*UpdateEmpleadoResponse, error)
+	Select(ctx context.Context, in *SelectEmpleado, opts ...grpc.CallOption) (*EmpleadoDetail, error)
+	Delete(ctx context.Context, in *DeleteEmpleado, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Restore(ctx context.Context, in *RestoreEmpleado, opts ...grpc.CallOption) (*UpdateEmpleadoResponse, error)
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	ListAudit(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditEntry], error)
+	ListGerentes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Gerente], error)
+	ListCargos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CargoList, error)
+	ListDepartamentosConDatos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DepartamentoList, error)
+	CreateUsuario(ctx context.Context, in *CreateUsuarioRequest, opts ...grpc.CallOption) (*Usuario, error)
+	UpdateUsuario(ctx context.Context, in *UpdateUsuarioRequest, opts ...grpc.CallOption) (*Usuario, error)
+	ListUsuarios(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UsuarioList, error)
+	ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RolList, error)
+	SaveRol(ctx context.Context, in *Rol, opts ...grpc.CallOption) (*Rol, error)
+	DeleteRol(ctx context.Context, in *DeleteRolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Subscribe envía los eventos de cambios hasta que el cliente cancela el
+	// stream o la sesión se revoca o expira, que lo termina con
+	// UNAUTHENTICATED. Llamar a Ping con el token mantiene la sesión activa.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Evento], error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebhookList, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListEntregasFallidas(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EntregaWebhookList, error)
+	RetryEntrega(ctx context.Context, in *RetryEntregaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type recursosHumanosClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecursosHumanosClient(cc grpc.ClientConnInterface) RecursosHumanosClient {
+	return &recursosHumanosClient{cc}
+}
+
+func (c *recursosHumanosClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthStatus)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Ping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthStatus)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Health_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Insert(ctx context.Context, in *CreateEmpleado, opts ...grpc.CallOption) (*CreateEmpleadoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmpleadoResponse)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Insert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Update(ctx context.Context, in *UpdateEmpleado, opts ...grpc.CallOption) (*UpdateEmpleadoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEmpleadoResponse)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Patch(ctx context.Context, in *PatchEmpleado, opts ...grpc.CallOption) (*UpdateEmpleadoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEmpleadoResponse)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Patch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Select(ctx context.Context, in *SelectEmpleado, opts ...grpc.CallOption) (*EmpleadoDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmpleadoDetail)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Select_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Delete(ctx context.Context, in *DeleteEmpleado, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Restore(ctx context.Context, in *RestoreEmpleado, opts ...grpc.CallOption) (*UpdateEmpleadoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEmpleadoResponse)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, RecursosHumanos_Batch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) ListAudit(ctx context.Context, in *ListAuditRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecursosHumanos_ServiceDesc.Streams[0], RecursosHumanos_ListAudit_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListAuditRequest, AuditEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursosHumanos_ListAuditClient = grpc.ServerStreamingClient[AuditEntry]
+
+func (c *recursosHumanosClient) ListGerentes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Gerente], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecursosHumanos_ServiceDesc.Streams[1], RecursosHumanos_ListGerentes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, Gerente]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursosHumanos_ListGerentesClient = grpc.ServerStreamingClient[Gerente]
+
+func (c *recursosHumanosClient) ListCargos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CargoList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CargoList)
+	err := c.cc.Invoke(ctx, RecursosHumanos_ListCargos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) ListDepartamentosConDatos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DepartamentoList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepartamentoList)
+	err := c.cc.Invoke(ctx, RecursosHumanos_ListDepartamentosConDatos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) CreateUsuario(ctx context.Context, in *CreateUsuarioRequest, opts ...grpc.CallOption) (*Usuario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Usuario)
+	err := c.cc.Invoke(ctx, RecursosHumanos_CreateUsuario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) UpdateUsuario(ctx context.Context, in *UpdateUsuarioRequest, opts ...grpc.CallOption) (*Usuario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Usuario)
+	err := c.cc.Invoke(ctx, RecursosHumanos_UpdateUsuario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) ListUsuarios(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UsuarioList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UsuarioList)
+	err := c.cc.Invoke(ctx, RecursosHumanos_ListUsuarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RolList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolList)
+	err := c.cc.Invoke(ctx, RecursosHumanos_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) SaveRol(ctx context.Context, in *Rol, opts ...grpc.CallOption) (*Rol, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rol)
+	err := c.cc.Invoke(ctx, RecursosHumanos_SaveRol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) DeleteRol(ctx context.Context, in *DeleteRolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecursosHumanos_DeleteRol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Evento], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecursosHumanos_ServiceDesc.Streams[2], RecursosHumanos_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, Evento]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursosHumanos_SubscribeClient = grpc.ServerStreamingClient[Evento]
+
+func (c *recursosHumanosClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, RecursosHumanos_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebhookList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookList)
+	err := c.cc.Invoke(ctx, RecursosHumanos_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, RecursosHumanos_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecursosHumanos_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) ListEntregasFallidas(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EntregaWebhookList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntregaWebhookList)
+	err := c.cc.Invoke(ctx, RecursosHumanos_ListEntregasFallidas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recursosHumanosClient) RetryEntrega(ctx context.Context, in *RetryEntregaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecursosHumanos_RetryEntrega_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecursosHumanosServer is the server API for RecursosHumanos service.
+// All implementations must embed UnimplementedRecursosHumanosServer
+// for forward compatibility.
+type RecursosHumanosServer interface {
+	// Login devuelve un token que se envía en el metadato
+	// "authorization: Bearer <token>" del resto de llamadas. Con mTLS se
+	// puede omitir la contraseña.
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Ping y Health no requieren token. Ping informa solo si el servidor
+	// atiende y, con token, renueva la sesión, como PING durante una
+	// suscripción. Health falla con UNAVAILABLE si el servidor no está listo.
+	Ping(context.Context, *emptypb.Empty) (*HealthStatus, error)
+	Health(context.Context, *emptypb.Empty) (*HealthStatus, error)
+	Insert(context.Context, *CreateEmpleado) (*CreateEmpleadoResponse, error)
+	Update(context.Context, *UpdateEmpleado) (*UpdateEmpleadoResponse, error)
+	Patch(context.Context, *PatchEmpleado) (*UpdateEmpleadoResponse, error)
+	Select(context.Context, *SelectEmpleado) (*EmpleadoDetail, error)
+	Delete(context.Context, *DeleteEmpleado) (*emptypb.Empty, error)
+	Restore(context.Context, *RestoreEmpleado) (*UpdateEmpleadoResponse, error)
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	ListAudit(*ListAuditRequest, grpc.ServerStreamingServer[AuditEntry]) error
+	ListGerentes(*emptypb.Empty, grpc.ServerStreamingServer[Gerente]) error
+	ListCargos(context.Context, *emptypb.Empty) (*CargoList, error)
+	ListDepartamentosConDatos(context.Context, *emptypb.Empty) (*DepartamentoList, error)
+	CreateUsuario(context.Context, *CreateUsuarioRequest) (*Usuario, error)
+	UpdateUsuario(context.Context, *UpdateUsuarioRequest) (*Usuario, error)
+	ListUsuarios(context.Context, *emptypb.Empty) (*UsuarioList, error)
+	ListRoles(context.Context, *emptypb.Empty) (*RolList, error)
+	SaveRol(context.Context, *Rol) (*Rol, error)
+	DeleteRol(context.Context, *DeleteRolRequest) (*emptypb.Empty, error)
+	// Subscribe envía los eventos de cambios hasta que el cliente cancela el
+	// stream o la sesión se revoca o expira, que lo termina con
+	// UNAUTHENTICATED. Llamar a Ping con el token mantiene la sesión activa.
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Evento]) error
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	ListWebhooks(context.Context, *emptypb.Empty) (*WebhookList, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	ListEntregasFallidas(context.Context, *emptypb.Empty) (*EntregaWebhookList, error)
+	RetryEntrega(context.Context, *RetryEntregaRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedRecursosHumanosServer()
+}
+
+// UnimplementedRecursosHumanosServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecursosHumanosServer struct{}
+
+func (UnimplementedRecursosHumanosServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Ping(context.Context, *emptypb.Empty) (*HealthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Health(context.Context, *emptypb.Empty) (*HealthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Insert(context.Context, *CreateEmpleado) (*CreateEmpleadoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Update(context.Context, *UpdateEmpleado) (*UpdateEmpleadoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Patch(context.Context, *PatchEmpleado) (*UpdateEmpleadoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Patch not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Select(context.Context, *SelectEmpleado) (*EmpleadoDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Select not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Delete(context.Context, *DeleteEmpleado) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Restore(context.Context, *RestoreEmpleado) (*UpdateEmpleadoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Batch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListAudit(*ListAuditRequest, grpc.ServerStreamingServer[AuditEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method ListAudit not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListGerentes(*emptypb.Empty, grpc.ServerStreamingServer[Gerente]) error {
+	return status.Errorf(codes.Unimplemented, "method ListGerentes not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListCargos(context.Context, *emptypb.Empty) (*CargoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCargos not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListDepartamentosConDatos(context.Context, *emptypb.Empty) (*DepartamentoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartamentosConDatos not implemented")
+}
+func (UnimplementedRecursosHumanosServer) CreateUsuario(context.Context, *CreateUsuarioRequest) (*Usuario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUsuario not implemented")
+}
+func (UnimplementedRecursosHumanosServer) UpdateUsuario(context.Context, *UpdateUsuarioRequest) (*Usuario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsuario not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListUsuarios(context.Context, *emptypb.Empty) (*UsuarioList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsuarios not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListRoles(context.Context, *emptypb.Empty) (*RolList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (UnimplementedRecursosHumanosServer) SaveRol(context.Context, *Rol) (*Rol, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRol not implemented")
+}
+func (UnimplementedRecursosHumanosServer) DeleteRol(context.Context, *DeleteRolRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRol not implemented")
+}
+func (UnimplementedRecursosHumanosServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Evento]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedRecursosHumanosServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListWebhooks(context.Context, *emptypb.Empty) (*WebhookList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedRecursosHumanosServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedRecursosHumanosServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedRecursosHumanosServer) ListEntregasFallidas(context.Context, *emptypb.Empty) (*EntregaWebhookList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntregasFallidas not implemented")
+}
+func (UnimplementedRecursosHumanosServer) RetryEntrega(context.Context, *RetryEntregaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryEntrega not implemented")
+}
+func (UnimplementedRecursosHumanosServer) mustEmbedUnimplementedRecursosHumanosServer() {}
+func (UnimplementedRecursosHumanosServer) testEmbeddedByValue()                         {}
+
+// UnsafeRecursosHumanosServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecursosHumanosServer will
+// result in compilation errors.
+type UnsafeRecursosHumanosServer interface {
+	mustEmbedUnimplementedRecursosHumanosServer()
+}
+
+func RegisterRecursosHumanosServer(s grpc.ServiceRegistrar, srv RecursosHumanosServer) {
+	// If the following call pancis, it indicates UnimplementedRecursosHumanosServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecursosHumanos_ServiceDesc, srv)
+}
+
+func _RecursosHumanos_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Logout(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Ping(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Health(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmpleado)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Insert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Insert(ctx, req.(*CreateEmpleado))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmpleado)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Update(ctx, req.(*UpdateEmpleado))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchEmpleado)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Patch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Patch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Patch(ctx, req.(*PatchEmpleado))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Select_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectEmpleado)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Select(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Select_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Select(ctx, req.(*SelectEmpleado))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmpleado)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Delete(ctx, req.(*DeleteEmpleado))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEmpleado)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Restore(ctx, req.(*RestoreEmpleado))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_ListAudit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuditRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecursosHumanosServer).ListAudit(m, &grpc.GenericServerStream[ListAuditRequest, AuditEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursosHumanos_ListAuditServer = grpc.ServerStreamingServer[AuditEntry]
+
+func _RecursosHumanos_ListGerentes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecursosHumanosServer).ListGerentes(m, &grpc.GenericServerStream[emptypb.Empty, Gerente]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursosHumanos_ListGerentesServer = grpc.ServerStreamingServer[Gerente]
+
+func _RecursosHumanos_ListCargos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).ListCargos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_ListCargos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).ListCargos(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_ListDepartamentosConDatos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).ListDepartamentosConDatos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_ListDepartamentosConDatos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).ListDepartamentosConDatos(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_CreateUsuario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUsuarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).CreateUsuario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_CreateUsuario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).CreateUsuario(ctx, req.(*CreateUsuarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_UpdateUsuario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUsuarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).UpdateUsuario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_UpdateUsuario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).UpdateUsuario(ctx, req.(*UpdateUsuarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_ListUsuarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).ListUsuarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_ListUsuarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).ListUsuarios(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).ListRoles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_SaveRol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rol)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).SaveRol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_SaveRol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).SaveRol(ctx, req.(*Rol))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_DeleteRol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).DeleteRol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_DeleteRol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).DeleteRol(ctx, req.(*DeleteRolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecursosHumanosServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, Evento]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecursosHumanos_SubscribeServer = grpc.ServerStreamingServer[Evento]
+
+func _RecursosHumanos_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).ListWebhooks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_ListEntregasFallidas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).ListEntregasFallidas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_ListEntregasFallidas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).ListEntregasFallidas(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecursosHumanos_RetryEntrega_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryEntregaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecursosHumanosServer).RetryEntrega(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecursosHumanos_RetryEntrega_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecursosHumanosServer).RetryEntrega(ctx, req.(*RetryEntregaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecursosHumanos_ServiceDesc is the grpc.ServiceDesc for RecursosHumanos service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecursosHumanos_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hr.v1.RecursosHumanos",
+	HandlerType: (*RecursosHumanosServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _RecursosHumanos_Login_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _RecursosHumanos_Logout_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _RecursosHumanos_Ping_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _RecursosHumanos_Health_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _RecursosHumanos_Insert_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _RecursosHumanos_Update_Handler,
+		},
+		{
+			MethodName: "Patch",
+			Handler:    _RecursosHumanos_Patch_Handler,
+		},
+		{
+			MethodName: "Select",
+			Handler:    _RecursosHumanos_Select_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _RecursosHumanos_Delete_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _RecursosHumanos_Restore_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _RecursosHumanos_Batch_Handler,
+		},
+		{
+			MethodName: "ListCargos",
+			Handler:    _RecursosHumanos_ListCargos_Handler,
+		},
+		{
+			MethodName: "ListDepartamentosConDatos",
+			Handler:    _RecursosHumanos_ListDepartamentosConDatos_Handler,
+		},
+		{
+			MethodName: "CreateUsuario",
+			Handler:    _RecursosHumanos_CreateUsuario_Handler,
+		},
+		{
+			MethodName: "UpdateUsuario",
+			Handler:    _RecursosHumanos_UpdateUsuario_Handler,
+		},
+		{
+			MethodName: "ListUsuarios",
+			Handler:    _RecursosHumanos_ListUsuarios_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _RecursosHumanos_ListRoles_Handler,
+		},
+		{
+			MethodName: "SaveRol",
+			Handler:    _RecursosHumanos_SaveRol_Handler,
+		},
+		{
+			MethodName: "DeleteRol",
+			Handler:    _RecursosHumanos_DeleteRol_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _RecursosHumanos_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _RecursosHumanos_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _RecursosHumanos_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _RecursosHumanos_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListEntregasFallidas",
+			Handler:    _RecursosHumanos_ListEntregasFallidas_Handler,
+		},
+		{
+			MethodName: "RetryEntrega",
+			Handler:    _RecursosHumanos_RetryEntrega_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAudit",
+			Handler:       _RecursosHumanos_ListAudit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListGerentes",
+			Handler:       _RecursosHumanos_ListGerentes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _RecursosHumanos_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hr.proto",
+}
@@ -0,0 +1,69 @@
+package shared
+
+import "encoding/json"
+
+// Categorías de datos sensibles y el permiso que permite verlas.
+const (
+	CategoriaCompensacion    = "compensacion"
+	CategoriaDatosPersonales = "datos_personales"
+
+	PermisoVerCompensacion    = "VER_COMPENSACION"
+	PermisoVerDatosPersonales = "VER_DATOS_PERSONALES"
+)
+
+var PermisoPorCategoria = map[string]string{
+	CategoriaCompensacion:    PermisoVerCompensacion,
+	CategoriaDatosPersonales: PermisoVerDatosPersonales,
+}
+
+// CamposSensibles declara, por nombre de campo JSON, la categoría de cada
+// dato enmascarable. Incluye los nombres de las columnas porque la auditoría
+// guarda las filas tal cual.
+var CamposSensibles = map[string]string{
+	"sueldo":         CategoriaCompensacion,
+	"empl_sueldo":    CategoriaCompensacion,
+	"comision":       CategoriaCompensacion,
+	"empl_comision":  CategoriaCompensacion,
+	"email":          CategoriaDatosPersonales,
+	"empl_email":     CategoriaDatosPersonales,
+	"fecha_nac":      CategoriaDatosPersonales,
+	"empl_fecha_nac": CategoriaDatosPersonales,
+}
+
+// MaskFields devuelve data con los campos de las categorías ocultas en null,
+// a cualquier profundidad. Si no hay categorías ocultas devuelve data intacto.
+func MaskFields(data any, ocultas map[string]bool) (any, error) {
+	if data == nil || len(ocultas) == 0 {
+		return data, nil
+	}
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(jsonData, &generic); err != nil {
+		return nil, err
+	}
+	return maskValue(generic, ocultas), nil
+}
+
+func maskValue(value any, ocultas map[string]bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if ocultas[CamposSensibles[key]] {
+				v[key] = nil
+				continue
+			}
+			v[key] = maskValue(item, ocultas)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = maskValue(item, ocultas)
+		}
+		return v
+	default:
+		return v
+	}
+}
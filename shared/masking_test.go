@@ -0,0 +1,99 @@
+package shared
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMaskFields(t *testing.T) {
+	compensacion := map[string]bool{CategoriaCompensacion: true}
+	casos := []struct {
+		name    string
+		data    any
+		ocultas map[string]bool
+		want    string
+	}{
+		{
+			name:    "sin categorías ocultas",
+			data:    EmpleadoDetailResponseDTO{ID: 1, Sueldo: 3000, Email: "ana@empresa.com"},
+			ocultas: nil,
+		},
+		{
+			name:    "mapa de categorías vacío",
+			data:    EmpleadoDetailResponseDTO{ID: 1, Sueldo: 3000, Email: "ana@empresa.com"},
+			ocultas: map[string]bool{},
+		},
+		{
+			name:    "campos de un DTO",
+			data:    map[string]any{"empl_id": 1, "sueldo": 3000, "comision": 5, "email": "ana@empresa.com"},
+			ocultas: compensacion,
+			want:    `{"empl_id":1,"sueldo":null,"comision":null,"email":"ana@empresa.com"}`,
+		},
+		{
+			name: "mapas anidados",
+			data: map[string]any{
+				"empleado": map[string]any{"email": "ana@empresa.com", "fecha_nac": "1990-05-15", "sueldo": 3000},
+			},
+			ocultas: map[string]bool{CategoriaDatosPersonales: true},
+			want:    `{"empleado":{"email":null,"fecha_nac":null,"sueldo":3000}}`,
+		},
+		{
+			name: "listas de filas",
+			data: []any{
+				map[string]any{"empl_id": 1, "sueldo": 3000},
+				map[string]any{"empl_id": 2, "sueldo": 4000, "otros": []any{map[string]any{"comision": 5}}},
+			},
+			ocultas: compensacion,
+			want:    `[{"empl_id":1,"sueldo":null},{"empl_id":2,"sueldo":null,"otros":[{"comision":null}]}]`,
+		},
+		{
+			name: "columnas de la auditoría",
+			data: AuditEntryDTO{
+				ID:        7,
+				Operacion: "UPDATE",
+				Antes:     json.RawMessage(`{"empl_ID":3,"empl_sueldo":3000,"empl_comision":5,"empl_email":"ana@empresa.com"}`),
+				Despues:   json.RawMessage(`{"empl_ID":3,"empl_sueldo":3500,"empl_comision":5,"empl_email":"ana@empresa.com"}`),
+			},
+			ocultas: compensacion,
+			want: `{"audit_id":7,"fecha":"","operador":"","cliente":"","operacion":"UPDATE","empl_id":null,
+				"antes":{"empl_ID":3,"empl_sueldo":null,"empl_comision":null,"empl_email":"ana@empresa.com"},
+				"despues":{"empl_ID":3,"empl_sueldo":null,"empl_comision":null,"empl_email":"ana@empresa.com"}}`,
+		},
+		{
+			name:    "auditoría sin fila anterior",
+			data:    AuditEntryDTO{ID: 8, Operacion: "INSERT", Despues: json.RawMessage(`{"empl_fecha_nac":"1990-05-15"}`)},
+			ocultas: map[string]bool{CategoriaDatosPersonales: true},
+			want: `{"audit_id":8,"fecha":"","operador":"","cliente":"","operacion":"INSERT","empl_id":null,
+				"antes":null,"despues":{"empl_fecha_nac":null}}`,
+		},
+	}
+	for _, c := range casos {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := MaskFields(c.data, c.ocultas)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.want == "" {
+				if !reflect.DeepEqual(got, c.data) {
+					t.Errorf("se modificaron los datos: %+v", got)
+				}
+				return
+			}
+			var want any
+			if err := json.Unmarshal([]byte(c.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("MaskFields = %+v, se esperaba %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestMaskFieldsNil(t *testing.T) {
+	got, err := MaskFields(nil, map[string]bool{CategoriaCompensacion: true})
+	if got != nil || err != nil {
+		t.Errorf("MaskFields(nil) = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,384 @@
+package shared
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Límites alineados con las columnas de server/migrations/postgres/0006_empleados.up.sql.
+const (
+	MaxPrimerNombreLen  = 50 // empl_primer_nombre VARCHAR(50)
+	MaxSegundoNombreLen = 50 // empl_segundo_nombre VARCHAR(50)
+	MaxEmailLen         = 100
+	MinSueldo           = 0.01        // el menor valor que empl_sueldo DECIMAL(10,2) no guarda como 0
+	MaxSueldo           = 99999999.99 // empl_sueldo DECIMAL(10,2)
+	MinComision         = 0
+	MaxComision         = 100 // empl_comision DECIMAL(5,2), porcentaje
+	FechaLayout         = "2006-01-02"
+	MaxUsuarioLen       = 50 // usr_nombre VARCHAR(50)
+	MinPasswordLen      = 8
+	MaxPasswordLen      = 72  // límite de bcrypt en bytes
+	MaxRolLen           = 30  // rol_nombre VARCHAR(30)
+	MaxWebhookURLLen    = 500 // wh_url VARCHAR(500)
+	MinSecretoLen       = 16
+	MaxSecretoLen       = 200 // wh_secreto VARCHAR(200)
+)
+
+// Roles predefinidos en server/migrations/postgres/0018_roles.up.sql. Se pueden crear
+// otros con SAVE_ROL; admin siempre conserva todos los permisos.
+const (
+	RolAdmin             = "admin"
+	RolHRManager         = "hr_manager"
+	RolHRAnalyst         = "hr_analyst"
+	RolDepartmentManager = "department_manager"
+	RolSelfService       = "self_service"
+)
+
+// Alcances de rol: qué empleados puede consultar y modificar el usuario.
+// departamento incluye su departamento y su línea de reporte; propio, solo
+// el empleado vinculado al usuario.
+const (
+	AlcanceTodos        = "todos"
+	AlcanceDepartamento = "departamento"
+	AlcancePropio       = "propio"
+)
+
+// PermisoTodo concede todas las operaciones.
+const PermisoTodo = "*"
+
+// Patrones de los nombres de usuario y de rol; server/openapi.go los publica
+// en el esquema.
+const (
+	UsuarioPattern = `^[a-zA-Z0-9._-]+$`
+	RolPattern     = `^[a-z0-9_]+$`
+)
+
+var usuarioRegex = regexp.MustCompile(UsuarioPattern)
+
+var rolRegex = regexp.MustCompile(RolPattern)
+
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// Normalize elimina los espacios sobrantes de los campos de texto, igual que
+// hace el cliente al leer la entrada, para que cliente y servidor validen y
+// guarden el mismo valor.
+func (dto *CreateEmpleadoDTO) Normalize() {
+	dto.PrimerNombre = strings.TrimSpace(dto.PrimerNombre)
+	dto.SegundoNombre = normalizeOptional(dto.SegundoNombre)
+	dto.Email = strings.TrimSpace(dto.Email)
+	dto.FechaNac = strings.TrimSpace(dto.FechaNac)
+}
+
+func (dto *UpdateEmpleadoDTO) Normalize() {
+	dto.PrimerNombre = strings.TrimSpace(dto.PrimerNombre)
+	dto.SegundoNombre = normalizeOptional(dto.SegundoNombre)
+	dto.Email = strings.TrimSpace(dto.Email)
+	dto.FechaNac = strings.TrimSpace(dto.FechaNac)
+}
+
+func (dto *PatchEmpleadoDTO) Normalize() {
+	if dto.PrimerNombre != nil {
+		trimmed := strings.TrimSpace(*dto.PrimerNombre)
+		dto.PrimerNombre = &trimmed
+	}
+	if dto.SegundoNombre != nil {
+		dto.SegundoNombre = normalizeOptional(dto.SegundoNombre)
+		if dto.SegundoNombre == nil {
+			dto.LimpiarSegundoNombre = true
+		}
+	}
+	if dto.Email != nil {
+		trimmed := strings.TrimSpace(*dto.Email)
+		dto.Email = &trimmed
+	}
+	if dto.FechaNac != nil {
+		trimmed := strings.TrimSpace(*dto.FechaNac)
+		dto.FechaNac = &trimmed
+	}
+}
+
+func normalizeOptional(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func ValidatePrimerNombre(nombre string) error {
+	if strings.TrimSpace(nombre) == "" {
+		return fmt.Errorf("primer nombre es requerido")
+	}
+	if utf8.RuneCountInString(nombre) > MaxPrimerNombreLen {
+		return fmt.Errorf("primer nombre no puede exceder %d caracteres", MaxPrimerNombreLen)
+	}
+	return nil
+}
+
+func ValidateSegundoNombre(nombre *string) error {
+	if nombre != nil && utf8.RuneCountInString(*nombre) > MaxSegundoNombreLen {
+		return fmt.Errorf("segundo nombre no puede exceder %d caracteres", MaxSegundoNombreLen)
+	}
+	return nil
+}
+
+func ValidateEmail(email string) error {
+	if strings.TrimSpace(email) == "" {
+		return fmt.Errorf("email es requerido")
+	}
+	if utf8.RuneCountInString(email) > MaxEmailLen {
+		return fmt.Errorf("email no puede exceder %d caracteres", MaxEmailLen)
+	}
+	if !emailRegex.MatchString(email) {
+		return fmt.Errorf("formato de email inválido")
+	}
+	return nil
+}
+
+func ValidateFechaNac(fecha string) error {
+	if strings.TrimSpace(fecha) == "" {
+		return fmt.Errorf("fecha de nacimiento es requerida")
+	}
+	if _, err := time.Parse(FechaLayout, fecha); err != nil {
+		return fmt.Errorf("formato de fecha inválido, use YYYY-MM-DD")
+	}
+	return nil
+}
+
+func ValidateSueldo(sueldo float64) error {
+	if sueldo < MinSueldo {
+		return fmt.Errorf("sueldo debe ser al menos %.2f", MinSueldo)
+	}
+	if sueldo > MaxSueldo {
+		return fmt.Errorf("sueldo no puede exceder %.2f", MaxSueldo)
+	}
+	return nil
+}
+
+func ValidateComision(comision float64) error {
+	if comision < MinComision || comision > MaxComision {
+		return fmt.Errorf("comisión debe estar entre %d y %d", MinComision, MaxComision)
+	}
+	return nil
+}
+
+// ValidateVersion exige la versión leída con SELECT en las operaciones que
+// modifican un empleado existente.
+func ValidateVersion(version int) error {
+	if version <= 0 {
+		return fmt.Errorf("versión del empleado es requerida, consulte el empleado antes de modificarlo")
+	}
+	return nil
+}
+
+func ValidateCreateEmpleado(dto CreateEmpleadoDTO) error {
+	if err := ValidatePrimerNombre(dto.PrimerNombre); err != nil {
+		return err
+	}
+	if err := ValidateSegundoNombre(dto.SegundoNombre); err != nil {
+		return err
+	}
+	if err := ValidateEmail(dto.Email); err != nil {
+		return err
+	}
+	if err := ValidateFechaNac(dto.FechaNac); err != nil {
+		return err
+	}
+	if err := ValidateSueldo(dto.Sueldo); err != nil {
+		return err
+	}
+	if err := ValidateComision(dto.Comision); err != nil {
+		return err
+	}
+	if dto.CargoID <= 0 {
+		return fmt.Errorf("cargo ID es requerido y debe ser mayor a 0")
+	}
+	if dto.DptoID <= 0 {
+		return fmt.Errorf("departamento ID es requerido y debe ser mayor a 0")
+	}
+	if dto.GerenteID != nil && *dto.GerenteID <= 0 {
+		return fmt.Errorf("gerente ID debe ser mayor a 0 si se proporciona")
+	}
+	return nil
+}
+
+func ValidateUpdateEmpleado(dto UpdateEmpleadoDTO) error {
+	if dto.ID <= 0 {
+		return fmt.Errorf("ID del empleado es requerido y debe ser mayor a 0")
+	}
+	if err := ValidateVersion(dto.Version); err != nil {
+		return err
+	}
+	if dto.GerenteID != nil && *dto.GerenteID == dto.ID {
+		return fmt.Errorf("un empleado no puede ser su propio gerente")
+	}
+	return ValidateCreateEmpleado(CreateEmpleadoDTO{
+		PrimerNombre:  dto.PrimerNombre,
+		SegundoNombre: dto.SegundoNombre,
+		Email:         dto.Email,
+		FechaNac:      dto.FechaNac,
+		Sueldo:        dto.Sueldo,
+		Comision:      dto.Comision,
+		CargoID:       dto.CargoID,
+		GerenteID:     dto.GerenteID,
+		DptoID:        dto.DptoID,
+	})
+}
+
+func ValidatePatchEmpleado(dto PatchEmpleadoDTO) error {
+	if dto.ID <= 0 {
+		return fmt.Errorf("ID del empleado es requerido y debe ser mayor a 0")
+	}
+	if err := ValidateVersion(dto.Version); err != nil {
+		return err
+	}
+	if dto.SegundoNombre != nil && dto.LimpiarSegundoNombre {
+		return fmt.Errorf("no se puede enviar segundo nombre y limpiarlo a la vez")
+	}
+	if dto.GerenteID != nil && dto.LimpiarGerente {
+		return fmt.Errorf("no se puede enviar gerente ID y limpiarlo a la vez")
+	}
+	if dto.PrimerNombre != nil {
+		if err := ValidatePrimerNombre(*dto.PrimerNombre); err != nil {
+			return err
+		}
+	}
+	if err := ValidateSegundoNombre(dto.SegundoNombre); err != nil {
+		return err
+	}
+	if dto.Email != nil {
+		if err := ValidateEmail(*dto.Email); err != nil {
+			return err
+		}
+	}
+	if dto.FechaNac != nil {
+		if err := ValidateFechaNac(*dto.FechaNac); err != nil {
+			return err
+		}
+	}
+	if dto.Sueldo != nil {
+		if err := ValidateSueldo(*dto.Sueldo); err != nil {
+			return err
+		}
+	}
+	if dto.Comision != nil {
+		if err := ValidateComision(*dto.Comision); err != nil {
+			return err
+		}
+	}
+	if dto.CargoID != nil && *dto.CargoID <= 0 {
+		return fmt.Errorf("cargo ID debe ser mayor a 0")
+	}
+	if dto.DptoID != nil && *dto.DptoID <= 0 {
+		return fmt.Errorf("departamento ID debe ser mayor a 0")
+	}
+	if dto.GerenteID != nil && *dto.GerenteID <= 0 {
+		return fmt.Errorf("gerente ID debe ser mayor a 0 si se proporciona")
+	}
+	if dto.GerenteID != nil && *dto.GerenteID == dto.ID {
+		return fmt.Errorf("un empleado no puede ser su propio gerente")
+	}
+	return nil
+}
+
+// IsEmpty indica si el PATCH no modifica ningún campo.
+func (dto PatchEmpleadoDTO) IsEmpty() bool {
+	return dto.PrimerNombre == nil && dto.SegundoNombre == nil && !dto.LimpiarSegundoNombre &&
+		dto.Email == nil && dto.FechaNac == nil && dto.Sueldo == nil && dto.Comision == nil &&
+		dto.CargoID == nil && dto.GerenteID == nil && !dto.LimpiarGerente && dto.DptoID == nil
+}
+
+func ValidateUsuario(usuario string) error {
+	if usuario == "" {
+		return fmt.Errorf("usuario es requerido")
+	}
+	if utf8.RuneCountInString(usuario) > MaxUsuarioLen {
+		return fmt.Errorf("usuario no puede exceder %d caracteres", MaxUsuarioLen)
+	}
+	if !usuarioRegex.MatchString(usuario) {
+		return fmt.Errorf("usuario solo puede contener letras, números, '.', '_' y '-'")
+	}
+	return nil
+}
+
+func ValidatePassword(password string) error {
+	if utf8.RuneCountInString(password) < MinPasswordLen {
+		return fmt.Errorf("la contraseña debe tener al menos %d caracteres", MinPasswordLen)
+	}
+	if len(password) > MaxPasswordLen {
+		return fmt.Errorf("la contraseña no puede exceder %d bytes", MaxPasswordLen)
+	}
+	return nil
+}
+
+// ValidateRol comprueba el formato del nombre; la existencia del rol la
+// verifica el servidor.
+func ValidateRol(rol string) error {
+	if rol == "" {
+		return fmt.Errorf("rol es requerido")
+	}
+	if len(rol) > MaxRolLen {
+		return fmt.Errorf("rol no puede exceder %d caracteres", MaxRolLen)
+	}
+	if !rolRegex.MatchString(rol) {
+		return fmt.Errorf("rol solo puede contener minúsculas, números y '_'")
+	}
+	return nil
+}
+
+func ValidateAlcance(alcance string) error {
+	switch alcance {
+	case AlcanceTodos, AlcanceDepartamento, AlcancePropio:
+		return nil
+	default:
+		return fmt.Errorf("alcance '%s' no válido, use %s, %s o %s",
+			alcance, AlcanceTodos, AlcanceDepartamento, AlcancePropio)
+	}
+}
+
+var tiposEvento = []string{
+	EventoEmpleadoCreado, EventoEmpleadoActualizado, EventoEmpleadoEliminado, EventoEmpleadoRestaurado,
+	EventoCargoCreado, EventoCargoActualizado, EventoCargoEliminado,
+	EventoDepartamentoCreado, EventoDepartamentoActualizado, EventoDepartamentoEliminado,
+}
+
+// ValidateTipoEvento acepta un tipo de evento o una entidad ("empleado",
+// "empleado.*").
+func ValidateTipoEvento(tipo string) error {
+	entidad := strings.TrimSuffix(tipo, ".*")
+	for _, t := range tiposEvento {
+		if t == tipo || strings.HasPrefix(t, entidad+".") {
+			return nil
+		}
+	}
+	return fmt.Errorf("tipo de evento '%s' no válido", tipo)
+}
+
+func ValidateCreateWebhook(dto CreateWebhookDTO) error {
+	if dto.URL == "" {
+		return fmt.Errorf("url es requerida")
+	}
+	if len(dto.URL) > MaxWebhookURLLen {
+		return fmt.Errorf("url no puede exceder %d caracteres", MaxWebhookURLLen)
+	}
+	u, err := url.Parse(dto.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url debe ser una dirección http o https válida")
+	}
+	if len(dto.Secreto) < MinSecretoLen || len(dto.Secreto) > MaxSecretoLen {
+		return fmt.Errorf("secreto debe tener entre %d y %d caracteres", MinSecretoLen, MaxSecretoLen)
+	}
+	for _, tipo := range dto.Tipos {
+		if err := ValidateTipoEvento(tipo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
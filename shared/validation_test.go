@@ -0,0 +1,175 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+)
+
+func validEmpleado() CreateEmpleadoDTO {
+	return CreateEmpleadoDTO{
+		PrimerNombre: "Ana", Email: "ana@empresa.com", FechaNac: "1990-05-15",
+		Sueldo: 3000, Comision: 5, CargoID: 1, DptoID: 1,
+	}
+}
+
+// expectValidation verifica que err sea nil si want está vacío, o que
+// contenga want.
+func expectValidation(t *testing.T, name string, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Errorf("%s: error inesperado: %v", name, err)
+		}
+		return
+	}
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("%s: se esperaba %q, se obtuvo %v", name, want, err)
+	}
+}
+
+func TestValidateCreateEmpleado(t *testing.T) {
+	largo := strings.Repeat("a", MaxSegundoNombreLen+1)
+	gerente := 0
+	casos := []struct {
+		name   string
+		modify func(dto *CreateEmpleadoDTO)
+		want   string
+	}{
+		{"válido", func(*CreateEmpleadoDTO) {}, ""},
+		{"nombre vacío", func(dto *CreateEmpleadoDTO) { dto.PrimerNombre = "  " }, "primer nombre es requerido"},
+		{"nombre largo", func(dto *CreateEmpleadoDTO) { dto.PrimerNombre = strings.Repeat("ñ", MaxPrimerNombreLen+1) }, "no puede exceder"},
+		{"nombre con tildes", func(dto *CreateEmpleadoDTO) { dto.PrimerNombre = strings.Repeat("ñ", MaxPrimerNombreLen) }, ""},
+		{"segundo nombre largo", func(dto *CreateEmpleadoDTO) { dto.SegundoNombre = &largo }, "segundo nombre no puede exceder"},
+		{"email vacío", func(dto *CreateEmpleadoDTO) { dto.Email = "" }, "email es requerido"},
+		{"email inválido", func(dto *CreateEmpleadoDTO) { dto.Email = "ana@empresa" }, "formato de email inválido"},
+		{"fecha inválida", func(dto *CreateEmpleadoDTO) { dto.FechaNac = "15/05/1990" }, "formato de fecha inválido"},
+		{"sueldo cero", func(dto *CreateEmpleadoDTO) { dto.Sueldo = 0 }, "sueldo debe ser al menos 0.01"},
+		{"sueldo que se redondea a 0", func(dto *CreateEmpleadoDTO) { dto.Sueldo = 0.004 }, "sueldo debe ser al menos 0.01"},
+		{"sueldo mínimo", func(dto *CreateEmpleadoDTO) { dto.Sueldo = MinSueldo }, ""},
+		{"sueldo excesivo", func(dto *CreateEmpleadoDTO) { dto.Sueldo = MaxSueldo + 1 }, "sueldo no puede exceder"},
+		{"comisión negativa", func(dto *CreateEmpleadoDTO) { dto.Comision = -1 }, "comisión debe estar entre"},
+		{"comisión excesiva", func(dto *CreateEmpleadoDTO) { dto.Comision = MaxComision + 1 }, "comisión debe estar entre"},
+		{"sin cargo", func(dto *CreateEmpleadoDTO) { dto.CargoID = 0 }, "cargo ID es requerido"},
+		{"sin departamento", func(dto *CreateEmpleadoDTO) { dto.DptoID = 0 }, "departamento ID es requerido"},
+		{"gerente inválido", func(dto *CreateEmpleadoDTO) { dto.GerenteID = &gerente }, "gerente ID debe ser mayor a 0"},
+	}
+	for _, c := range casos {
+		dto := validEmpleado()
+		c.modify(&dto)
+		expectValidation(t, c.name, ValidateCreateEmpleado(dto), c.want)
+	}
+}
+
+func TestValidateUpdateEmpleado(t *testing.T) {
+	propio := 3
+	otro := 4
+	casos := []struct {
+		name   string
+		modify func(dto *UpdateEmpleadoDTO)
+		want   string
+	}{
+		{"válido", func(*UpdateEmpleadoDTO) {}, ""},
+		{"con gerente", func(dto *UpdateEmpleadoDTO) { dto.GerenteID = &otro }, ""},
+		{"sin ID", func(dto *UpdateEmpleadoDTO) { dto.ID = 0 }, "ID del empleado es requerido"},
+		{"sin versión", func(dto *UpdateEmpleadoDTO) { dto.Version = 0 }, "versión del empleado es requerida"},
+		{"propio gerente", func(dto *UpdateEmpleadoDTO) { dto.GerenteID = &propio }, "su propio gerente"},
+		{"campos del alta", func(dto *UpdateEmpleadoDTO) { dto.Email = "" }, "email es requerido"},
+	}
+	for _, c := range casos {
+		create := validEmpleado()
+		dto := UpdateEmpleadoDTO{
+			ID: 3, PrimerNombre: create.PrimerNombre, Email: create.Email, FechaNac: create.FechaNac,
+			Sueldo: create.Sueldo, Comision: create.Comision, CargoID: create.CargoID, DptoID: create.DptoID,
+			Version: 1,
+		}
+		c.modify(&dto)
+		expectValidation(t, c.name, ValidateUpdateEmpleado(dto), c.want)
+	}
+}
+
+func TestValidatePatchEmpleado(t *testing.T) {
+	nombre := "Beatriz"
+	vacio := ""
+	propio := 3
+	cero := 0
+	sueldo := -1.0
+	casos := []struct {
+		name string
+		dto  PatchEmpleadoDTO
+		want string
+	}{
+		{"válido", PatchEmpleadoDTO{ID: 3, PrimerNombre: &nombre, Version: 1}, ""},
+		{"sin ID", PatchEmpleadoDTO{PrimerNombre: &nombre, Version: 1}, "ID del empleado es requerido"},
+		{"sin versión", PatchEmpleadoDTO{ID: 3, PrimerNombre: &nombre}, "versión del empleado es requerida"},
+		{"segundo nombre y limpiar", PatchEmpleadoDTO{ID: 3, SegundoNombre: &nombre, LimpiarSegundoNombre: true, Version: 1}, "a la vez"},
+		{"gerente y limpiar", PatchEmpleadoDTO{ID: 3, GerenteID: &propio, LimpiarGerente: true, Version: 1}, "a la vez"},
+		{"nombre vacío", PatchEmpleadoDTO{ID: 3, PrimerNombre: &vacio, Version: 1}, "primer nombre es requerido"},
+		{"sueldo inválido", PatchEmpleadoDTO{ID: 3, Sueldo: &sueldo, Version: 1}, "sueldo debe ser al menos 0.01"},
+		{"cargo inválido", PatchEmpleadoDTO{ID: 3, CargoID: &cero, Version: 1}, "cargo ID debe ser mayor a 0"},
+		{"propio gerente", PatchEmpleadoDTO{ID: 3, GerenteID: &propio, Version: 1}, "su propio gerente"},
+	}
+	for _, c := range casos {
+		expectValidation(t, c.name, ValidatePatchEmpleado(c.dto), c.want)
+	}
+}
+
+func TestPatchEmpleadoNormalize(t *testing.T) {
+	nombre := "  Ana "
+	segundo := "   "
+	dto := PatchEmpleadoDTO{ID: 3, PrimerNombre: &nombre, SegundoNombre: &segundo, Version: 1}
+	dto.Normalize()
+	if *dto.PrimerNombre != "Ana" || dto.SegundoNombre != nil || !dto.LimpiarSegundoNombre {
+		t.Errorf("Normalize = %+v", dto)
+	}
+	if dto.IsEmpty() {
+		t.Error("el PATCH normalizado no debería estar vacío")
+	}
+	if !(PatchEmpleadoDTO{ID: 3, Version: 1}).IsEmpty() {
+		t.Error("un PATCH sin campos debería estar vacío")
+	}
+}
+
+func TestValidateUsuarioRolYPassword(t *testing.T) {
+	casos := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"usuario válido", ValidateUsuario("ana.perez-2"), ""},
+		{"usuario vacío", ValidateUsuario(""), "usuario es requerido"},
+		{"usuario largo", ValidateUsuario(strings.Repeat("a", MaxUsuarioLen+1)), "usuario no puede exceder"},
+		{"usuario con espacios", ValidateUsuario("ana perez"), "solo puede contener"},
+		{"rol válido", ValidateRol("hr_manager"), ""},
+		{"rol vacío", ValidateRol(""), "rol es requerido"},
+		{"rol con mayúsculas", ValidateRol("HR"), "solo puede contener"},
+		{"rol largo", ValidateRol(strings.Repeat("a", MaxRolLen+1)), "rol no puede exceder"},
+		{"contraseña válida", ValidatePassword("Secreta123!"), ""},
+		{"contraseña corta", ValidatePassword("corta"), "al menos"},
+		{"contraseña larga", ValidatePassword(strings.Repeat("ñ", MaxPasswordLen/2+1)), "no puede exceder"},
+		{"alcance válido", ValidateAlcance(AlcanceDepartamento), ""},
+		{"alcance inválido", ValidateAlcance("global"), "no válido"},
+	}
+	for _, c := range casos {
+		expectValidation(t, c.name, c.err, c.want)
+	}
+}
+
+func TestValidateCreateWebhook(t *testing.T) {
+	secreto := strings.Repeat("s", MinSecretoLen)
+	casos := []struct {
+		name string
+		dto  CreateWebhookDTO
+		want string
+	}{
+		{"válido", CreateWebhookDTO{URL: "https://hooks.empresa.com/hr", Secreto: secreto, Tipos: []string{"empleado.*"}}, ""},
+		{"tipo exacto", CreateWebhookDTO{URL: "http://localhost:8080", Secreto: secreto, Tipos: []string{EventoCargoCreado}}, ""},
+		{"sin url", CreateWebhookDTO{Secreto: secreto}, "url es requerida"},
+		{"esquema inválido", CreateWebhookDTO{URL: "ftp://empresa.com", Secreto: secreto}, "http o https"},
+		{"sin host", CreateWebhookDTO{URL: "https://", Secreto: secreto}, "http o https"},
+		{"secreto corto", CreateWebhookDTO{URL: "https://empresa.com", Secreto: "corto"}, "secreto debe tener"},
+		{"tipo inválido", CreateWebhookDTO{URL: "https://empresa.com", Secreto: secreto, Tipos: []string{"usuario.*"}}, "no válido"},
+	}
+	for _, c := range casos {
+		expectValidation(t, c.name, ValidateCreateWebhook(c.dto), c.want)
+	}
+}